@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/imagehash"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/spf13/cobra"
+)
+
+// blocklistCmd represents the blocklist command
+var blocklistCmd = &cobra.Command{
+	Use:   "blocklist",
+	Short: "Manage the prohibited image hash blocklist",
+}
+
+// blocklistLoadCmd 從檔案批次匯入封鎖的雜湊
+var blocklistLoadCmd = &cobra.Command{
+	Use:   "load <file>",
+	Short: "Bulk load hashes into the blocklist",
+	Long: `Bulk load prohibited image hashes from a file into the blocklist.
+
+The file holds one hash per line. A line may be prefixed with "sha256:" or
+"phash:" to set its type, otherwise --type is used. Blank lines and lines
+starting with # are ignored. Hashes already in the blocklist are skipped.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hashType, _ := cmd.Flags().GetString("type")
+		reason, _ := cmd.Flags().GetString("reason")
+		source, _ := cmd.Flags().GetString("source")
+		operator, _ := cmd.Flags().GetString("operator")
+
+		// 1. 讀取並檢查雜湊清單
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		entries, err := imagehash.ParseList(f, hashType)
+		if err != nil {
+			return fmt.Errorf("%s: %w", args[0], err)
+		}
+
+		// 2. 連線到資料庫
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancel()
+		if err := initMongo(ctx); err != nil {
+			return err
+		}
+		defer func() {
+			_ = mgo.Close(context.Background())
+		}()
+		if err := mgo.SyncIndexes(ctx); err != nil {
+			return err
+		}
+
+		// 3. 匯入雜湊並記錄稽核紀錄
+		inserted, err := db.LoadBlockedHashes(ctx, entries,
+			db.WithBlockedHashReason(reason, source),
+			db.WithBlockedHashCreator(operator))
+		if err != nil {
+			return err
+		}
+		err = db.SaveAuditLog(ctx,
+			db.WithAuditAction(db.AuditBlocklistLoad),
+			db.WithAuditActor(operator),
+			db.WithAuditDetail(map[string]string{
+				"file":     args[0],
+				"source":   source,
+				"total":    strconv.Itoa(len(entries)),
+				"inserted": strconv.FormatInt(inserted, 10),
+			}))
+		if err != nil {
+			log.Warn("failed to write blocklist audit log", log.Err(err))
+		}
+		fmt.Printf("loaded %d hashes, %d new, %d already blocked\n", len(entries), inserted, int64(len(entries))-inserted)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(blocklistCmd)
+	blocklistCmd.AddCommand(blocklistLoadCmd)
+
+	blocklistLoadCmd.Flags().String("type", imagehash.TypeSHA256, "hash type for lines without a prefix (sha256 or phash)")
+	blocklistLoadCmd.Flags().String("reason", "", "reason recorded on every loaded hash")
+	blocklistLoadCmd.Flags().String("source", "", "where the hash list came from")
+	blocklistLoadCmd.Flags().String("operator", "", "who is loading the list, recorded in the audit log")
+}
@@ -0,0 +1,18 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/spf13/viper"
+)
+
+// initMongo 依 database 設定連線到 MongoDB。
+func initMongo(ctx context.Context) error {
+	return mgo.InitConnection(ctx,
+		viper.GetString("database.db"),
+		mgo.WithURI(viper.GetString("database.uri")),
+		mgo.WithMaxPoolSize(viper.GetUint64("database.max_pool_size")),
+		mgo.WithMinPoolSize(viper.GetUint64("database.min_pool_size")),
+	)
+}
@@ -7,10 +7,11 @@ import (
 	"context"
 	"time"
 
-	_ "github.com/arwoosa/media/internal/db"
-	_ "github.com/arwoosa/media/internal/service"
+	"github.com/arwoosa/media/internal/broker"
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/rdb"
+	"github.com/arwoosa/media/internal/service"
 	"github.com/arwoosa/vulpes/codec"
-	"github.com/arwoosa/vulpes/db/cache"
 	"github.com/arwoosa/vulpes/db/mgo"
 	"github.com/arwoosa/vulpes/ezgrpc"
 	"github.com/arwoosa/vulpes/log"
@@ -32,13 +33,17 @@ The server can be started with either REST API or gRPC endpoints:
 `,
 	Run: func(cmd *cobra.Command, args []string) {
 		// initialize cache
-		err := cache.InitConnection(
-			cache.WithAddr(viper.GetString("cache.address")),
-			cache.WithPassword(viper.GetString("cache.password")),
-			cache.WithDb(viper.GetInt("cache.db")))
+		rdb.SetKeyPrefix(viper.GetString("cache.key_prefix"))
+		err := rdb.InitConnection(
+			rdb.WithAddr(viper.GetString("cache.address")),
+			rdb.WithPassword(viper.GetString("cache.password")),
+			rdb.WithDb(viper.GetInt("cache.db")))
 		if err != nil {
 			log.Fatal(err.Error())
 		}
+		defer func() {
+			_ = rdb.Close()
+		}()
 		// initialize relation
 		relation.Initialize(
 			relation.WithWriteAddr(viper.GetString("relation.write_uri")),
@@ -46,12 +51,7 @@ The server can be started with either REST API or gRPC endpoints:
 
 		// initialize mongo
 		mongoCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
-		err = mgo.InitConnection(mongoCtx,
-			viper.GetString("database.db"),
-			mgo.WithURI(viper.GetString("database.uri")),
-			mgo.WithMaxPoolSize(viper.GetUint64("database.max_pool_size")),
-			mgo.WithMinPoolSize(viper.GetUint64("database.min_pool_size")),
-		)
+		err = initMongo(mongoCtx)
 		if err != nil {
 			log.Fatal(err.Error())
 		}
@@ -69,6 +69,22 @@ The server can be started with either REST API or gRPC endpoints:
 		if err != nil {
 			log.Fatal(err.Error())
 		}
+		// 補上全文檢索需要的欄位
+		backfilled, err := db.BackfillImageMetaValues(mongoCtx)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		if backfilled > 0 {
+			log.Info("backfilled image meta values", log.Int64("count", backfilled))
+		}
+		// 將加入用量統計前建立的圖片計入擁有者的用量
+		backfilled, err = db.BackfillStorageUsage(mongoCtx)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		if backfilled > 0 {
+			log.Info("backfilled storage usage", log.Int64("count", backfilled))
+		}
 		cancel()
 
 		ezgrpc.InitSessionStore()
@@ -76,7 +92,7 @@ The server can be started with either REST API or gRPC endpoints:
 
 		codec.WithCodecMethod(codec.GOB)
 		ezgrpc.SetServeMuxOpts(
-			ezgrpc.DefaultHeaderMatcher,
+			service.IncomingHeaderMatcher,
 			ezgrpc.OutgoingHeaderMatcher,
 			ezgrpc.SessionCookieForwarder,
 			ezgrpc.SessionCookieExtractor,
@@ -86,6 +102,32 @@ The server can be started with either REST API or gRPC endpoints:
 
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
+
+		// 未設定外部 Cron Job 時，由內部 ticker 定期同步瀏覽次數
+		if interval := viper.GetDuration("count.sync_interval"); interval > 0 {
+			go service.RunImageCountSync(ctx, interval)
+		}
+		// 未設定外部 Cron Job 時，由內部 ticker 定期回收未使用的圖片
+		if interval := viper.GetDuration("gc.interval"); interval > 0 {
+			go service.RunImageGC(ctx, interval)
+		}
+
+		// 將 outbox 中的領域事件發佈到 broker，未設定 broker 時事件保留在 outbox 中
+		eventBroker, err := broker.FromViper()
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		if eventBroker != nil {
+			defer func() {
+				_ = eventBroker.Close()
+			}()
+			interval := viper.GetDuration("outbox.relay_interval")
+			if interval <= 0 {
+				interval = time.Second
+			}
+			go service.RunOutboxRelay(ctx, eventBroker, interval)
+		}
+
 		err = ezgrpc.RunGrpcGateway(ctx, viper.GetInt("server.port"))
 		if err != nil {
 			log.Fatal(err.Error())
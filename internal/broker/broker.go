@@ -0,0 +1,71 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	TypeMemory = "memory"
+	TypeRedis  = "redis"
+)
+
+var (
+	ErrUnknownBroker = errors.New("unknown broker type")
+	ErrPublishFailed = errors.New("publish failed")
+)
+
+// Message 是發佈到 broker 的事件，Topic 為事件名稱，Key 為事件所屬資源的 ID。
+// 同一個事件可能發佈多次，訂閱者需要以 ID 去除重複。
+type Message struct {
+	ID      string
+	Topic   string
+	Key     string
+	Payload []byte // JSON 格式的事件內容
+}
+
+// Broker 將事件發佈給下游服務，Publish 回傳 nil 表示 broker 已經收下事件。
+type Broker interface {
+	Publish(ctx context.Context, msg Message) error
+	Close() error
+}
+
+// Factory 依 outbox.broker 設定建立 Broker。
+type Factory func() (Broker, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{}
+)
+
+// Register 註冊 broker 類型，讓 NATS、Kafka 等 broker 可以在不修改此套件的情況下接入。
+// 重複註冊同一個類型時，後註冊的會取代先註冊的。
+func Register(brokerType string, f Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[brokerType] = f
+}
+
+// FromViper 依 outbox.broker.type 建立 broker，未設定時回傳 nil 表示不發佈事件。
+func FromViper() (Broker, error) {
+	switch t := viper.GetString("outbox.broker.type"); t {
+	case "":
+		return nil, nil
+	case TypeMemory:
+		return NewMemory(), nil
+	case TypeRedis:
+		return NewRedisFromViper()
+	default:
+		factoriesMu.RLock()
+		f, ok := factories[t]
+		factoriesMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownBroker, t)
+		}
+		return f()
+	}
+}
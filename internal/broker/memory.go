@@ -0,0 +1,45 @@
+package broker
+
+import (
+	"context"
+	"sync"
+)
+
+// Memory 將事件保存在記憶體中，用於測試與本機開發。
+type Memory struct {
+	mu       sync.Mutex
+	messages []Message
+	err      error
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Publish(ctx context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+func (m *Memory) Close() error {
+	return nil
+}
+
+// Messages 回傳已發佈的事件，依發佈順序排列。
+func (m *Memory) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message(nil), m.messages...)
+}
+
+// Fail 讓之後的 Publish 都回傳 err，傳入 nil 時恢復正常，用於測試發佈失敗的情況。
+func (m *Memory) Fail(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.err = err
+}
@@ -0,0 +1,49 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemory(t *testing.T) {
+	m := NewMemory()
+	assert.NoError(t, m.Publish(context.Background(), Message{ID: "1", Topic: "image.uploaded"}))
+
+	m.Fail(errors.New("down"))
+	assert.Error(t, m.Publish(context.Background(), Message{ID: "2", Topic: "image.deleted"}))
+	m.Fail(nil)
+	assert.NoError(t, m.Publish(context.Background(), Message{ID: "3", Topic: "image.deleted"}))
+
+	msgs := m.Messages()
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, "1", msgs[0].ID)
+	assert.Equal(t, "3", msgs[1].ID)
+}
+
+func TestFromViper(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+
+	b, err := FromViper()
+	assert.NoError(t, err)
+	assert.Nil(t, b)
+
+	viper.Set("outbox.broker.type", TypeMemory)
+	b, err = FromViper()
+	assert.NoError(t, err)
+	assert.IsType(t, &Memory{}, b)
+
+	viper.Set("outbox.broker.type", "nats")
+	_, err = FromViper()
+	assert.ErrorIs(t, err, ErrUnknownBroker)
+
+	stub := NewMemory()
+	Register("nats", func() (Broker, error) { return stub, nil })
+	b, err = FromViper()
+	assert.NoError(t, err)
+	assert.Same(t, stub, b)
+}
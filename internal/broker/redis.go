@@ -0,0 +1,72 @@
+package broker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/spf13/viper"
+)
+
+const defaultStreamPrefix = "media:events:"
+
+// Redis 將事件寫入 Redis Streams，每個 topic 對應一個 stream，下游服務以 consumer group 讀取。
+type Redis struct {
+	client *redis.Client
+	prefix string
+	maxLen int64
+}
+
+// NewRedis 建立 Redis Streams broker，maxLen 大於 0 時以近似長度修剪 stream。
+func NewRedis(client *redis.Client, prefix string, maxLen int64) *Redis {
+	if prefix == "" {
+		prefix = defaultStreamPrefix
+	}
+	return &Redis{
+		client: client,
+		prefix: prefix,
+		maxLen: maxLen,
+	}
+}
+
+// NewRedisFromViper 依 outbox.broker.redis 設定建立 Redis Streams broker，未設定 address 時使用 cache 的 Redis。
+func NewRedisFromViper() (Broker, error) {
+	prefix := "outbox.broker.redis."
+	if viper.GetString(prefix+"address") == "" {
+		prefix = "cache."
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr:     viper.GetString(prefix + "address"),
+		Password: viper.GetString(prefix + "password"),
+		DB:       viper.GetInt(prefix + "db"),
+	})
+	return NewRedis(client,
+		viper.GetString("outbox.broker.redis.stream_prefix"),
+		viper.GetInt64("outbox.broker.redis.max_len")), nil
+}
+
+// stream 回傳 topic 對應的 stream 名稱。
+func (r *Redis) stream(topic string) string {
+	return r.prefix + topic
+}
+
+func (r *Redis) Publish(ctx context.Context, msg Message) error {
+	err := r.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: r.stream(msg.Topic),
+		MaxLen: r.maxLen,
+		Approx: r.maxLen > 0,
+		Values: map[string]any{
+			"id":      msg.ID,
+			"key":     msg.Key,
+			"payload": msg.Payload,
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrPublishFailed, err)
+	}
+	return nil
+}
+
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
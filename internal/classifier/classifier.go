@@ -0,0 +1,105 @@
+package classifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	TypeLocal = "local"
+	TypeHTTP  = "http"
+
+	// maxImageBytes 是下載圖片內容進行分類的上限。
+	maxImageBytes = 10 << 20
+)
+
+var (
+	ErrUnknownClassifier = errors.New("unknown classifier type")
+	ErrClassifyFailed    = errors.New("classify failed")
+)
+
+// Image 是送交分類的圖片。
+type Image struct {
+	ID       string            `json:"image_id"`
+	Filename string            `json:"filename"`
+	Meta     map[string]string `json:"meta,omitempty"`
+	URL      string            `json:"url"` // 可下載圖片內容的 URL
+}
+
+// Label 是分類結果的標籤，Score 介於 0 到 1。
+type Label struct {
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+// Result 是分類器回傳的標籤與分數。
+type Result struct {
+	Labels []Label `json:"labels"`
+}
+
+// Flagged 回傳分數達到 threshold 的標籤。
+func (r *Result) Flagged(threshold float64) []Label {
+	if r == nil {
+		return nil
+	}
+	var flagged []Label
+	for _, l := range r.Labels {
+		if l.Score >= threshold {
+			flagged = append(flagged, l)
+		}
+	}
+	return flagged
+}
+
+// Classifier 在圖片上線前檢查內容是否安全。
+type Classifier interface {
+	Classify(ctx context.Context, img Image) (*Result, error)
+}
+
+// FromViper 依 classifier.type 建立分類器，未設定時回傳 nil 表示不檢查。
+func FromViper() (Classifier, error) {
+	switch t := viper.GetString("classifier.type"); t {
+	case "":
+		return nil, nil
+	case TypeLocal:
+		return NewLocal(
+			viper.GetStringSlice("classifier.local.keywords"),
+			viper.GetStringSlice("classifier.local.sha256")), nil
+	case TypeHTTP:
+		return NewHTTP(
+			viper.GetString("classifier.http.url"),
+			viper.GetString("classifier.http.token"),
+			viper.GetDuration("classifier.http.timeout"))
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownClassifier, t)
+	}
+}
+
+// fetchImage 下載圖片內容，超過 maxImageBytes 時回傳錯誤。
+func fetchImage(ctx context.Context, client *http.Client, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch image: unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxImageBytes {
+		return nil, fmt.Errorf("fetch image: exceeds %d bytes", maxImageBytes)
+	}
+	return data, nil
+}
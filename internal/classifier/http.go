@@ -0,0 +1,64 @@
+package classifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const defaultHTTPTimeout = 5 * time.Second
+
+// HTTP 將圖片資訊以 JSON POST 到外部分類服務，服務回傳 {"labels": [{"name": "...", "score": 0.9}]}。
+type HTTP struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func NewHTTP(url, token string, timeout time.Duration) (*HTTP, error) {
+	if url == "" {
+		return nil, fmt.Errorf("%w: classifier.http.url is required", ErrUnknownClassifier)
+	}
+	if timeout <= 0 {
+		timeout = defaultHTTPTimeout
+	}
+	return &HTTP{
+		url:    url,
+		token:  token,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Classify 呼叫外部分類服務，token 不為空時以 Bearer 驗證。
+func (h *HTTP) Classify(ctx context.Context, img Image) (*Result, error) {
+	body, err := json.Marshal(img)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrClassifyFailed, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrClassifyFailed, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h.token != "" {
+		req.Header.Set("Authorization", "Bearer "+h.token)
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrClassifyFailed, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("%w: status %d: %s", ErrClassifyFailed, resp.StatusCode, bytes.TrimSpace(msg))
+	}
+	result := &Result{}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf("%w: decode response: %w", ErrClassifyFailed, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,47 @@
+package classifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPClassify(t *testing.T) {
+	var got Image
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		_, _ = w.Write([]byte(`{"labels":[{"name":"nudity","score":0.93},{"name":"violence","score":0.1}]}`))
+	}))
+	defer stub.Close()
+
+	c, err := NewHTTP(stub.URL, "secret", time.Second)
+	assert.NoError(t, err)
+	result, err := c.Classify(context.Background(), Image{ID: "img-1", Filename: "a.jpg", URL: "https://cdn/img-1/public"})
+	assert.NoError(t, err)
+	assert.Equal(t, "img-1", got.ID)
+	assert.Equal(t, "https://cdn/img-1/public", got.URL)
+	assert.Len(t, result.Labels, 2)
+	assert.Equal(t, []Label{{Name: "nudity", Score: 0.93}}, result.Flagged(0.8))
+}
+
+func TestHTTPClassifyError(t *testing.T) {
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "model unavailable", http.StatusServiceUnavailable)
+	}))
+	defer stub.Close()
+
+	c, err := NewHTTP(stub.URL, "", time.Second)
+	assert.NoError(t, err)
+	_, err = c.Classify(context.Background(), Image{ID: "img-1"})
+	assert.ErrorIs(t, err, ErrClassifyFailed)
+
+	_, err = NewHTTP("", "", 0)
+	assert.ErrorIs(t, err, ErrUnknownClassifier)
+}
@@ -0,0 +1,65 @@
+package classifier
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Local 是不依賴外部服務的規則式分類器：檔名或 metadata 含有關鍵字，或內容的 SHA-256 在封鎖清單中時標記圖片。
+type Local struct {
+	keywords []string
+	sha256   map[string]struct{}
+	client   *http.Client
+}
+
+func NewLocal(keywords, sha256Hashes []string) *Local {
+	l := &Local{
+		sha256: make(map[string]struct{}, len(sha256Hashes)),
+		client: http.DefaultClient,
+	}
+	for _, k := range keywords {
+		if k = strings.ToLower(strings.TrimSpace(k)); k != "" {
+			l.keywords = append(l.keywords, k)
+		}
+	}
+	for _, h := range sha256Hashes {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			l.sha256[h] = struct{}{}
+		}
+	}
+	return l
+}
+
+// Classify 比對關鍵字與 SHA-256 封鎖清單，命中的規則以分數 1 的標籤回傳。
+// 只有設定了封鎖清單時才會下載圖片內容。
+func (l *Local) Classify(ctx context.Context, img Image) (*Result, error) {
+	result := &Result{}
+	text := []string{strings.ToLower(img.Filename)}
+	for _, v := range img.Meta {
+		text = append(text, strings.ToLower(v))
+	}
+	for _, k := range l.keywords {
+		for _, t := range text {
+			if strings.Contains(t, k) {
+				result.Labels = append(result.Labels, Label{Name: "keyword:" + k, Score: 1})
+				break
+			}
+		}
+	}
+	if len(l.sha256) == 0 {
+		return result, nil
+	}
+	data, err := fetchImage(ctx, l.client, img.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrClassifyFailed, err)
+	}
+	sum := sha256.Sum256(data)
+	if _, ok := l.sha256[hex.EncodeToString(sum[:])]; ok {
+		result.Labels = append(result.Labels, Label{Name: "blocklist:sha256", Score: 1})
+	}
+	return result, nil
+}
@@ -0,0 +1,45 @@
+package classifier
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalKeywords(t *testing.T) {
+	c := NewLocal([]string{" Gore ", ""}, nil)
+	result, err := c.Classify(context.Background(), Image{Filename: "holiday.jpg", Meta: map[string]string{"title": "GORE scene"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []Label{{Name: "keyword:gore", Score: 1}}, result.Labels)
+
+	result, err = c.Classify(context.Background(), Image{Filename: "holiday.jpg"})
+	assert.NoError(t, err)
+	assert.Empty(t, result.Flagged(0.5))
+}
+
+func TestLocalSHA256(t *testing.T) {
+	content := []byte("blocked image bytes")
+	sum := sha256.Sum256(content)
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/blocked" {
+			_, _ = w.Write(content)
+			return
+		}
+		_, _ = w.Write([]byte("fine"))
+	}))
+	defer stub.Close()
+
+	c := NewLocal(nil, []string{hex.EncodeToString(sum[:])})
+	result, err := c.Classify(context.Background(), Image{URL: stub.URL + "/blocked"})
+	assert.NoError(t, err)
+	assert.Equal(t, []Label{{Name: "blocklist:sha256", Score: 1}}, result.Labels)
+
+	result, err = c.Classify(context.Background(), Image{URL: stub.URL + "/fine"})
+	assert.NoError(t, err)
+	assert.Empty(t, result.Labels)
+}
@@ -0,0 +1,37 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	cloudflare "github.com/cloudflare/cloudflare-go/v4"
+	images "github.com/cloudflare/cloudflare-go/v4/images"
+	"github.com/cloudflare/cloudflare-go/v4/option"
+)
+
+// GetImageBlob 下載上傳時的原始圖片內容，超過 maxBytes 時回傳錯誤。
+func GetImageBlob(ctx context.Context, id string, maxBytes int64) ([]byte, error) {
+	if err := checkConfig(); err != nil {
+		return nil, err
+	}
+	service := images.NewV1BlobService(
+		option.WithAPIToken(apiToken),
+		option.WithEnvironmentProduction(),
+	)
+	resp, err := service.Get(ctx, id, images.V1BlobGetParams{
+		AccountID: cloudflare.F(accountID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCloudflareCallFailed, err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCloudflareCallFailed, err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("%w: image %s exceeds %d bytes", ErrCloudflareCallFailed, id, maxBytes)
+	}
+	return data, nil
+}
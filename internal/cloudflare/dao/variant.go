@@ -0,0 +1,11 @@
+package dao
+
+// Variant 是 Cloudflare 上的變體定義，Fit 與 Metadata 使用 Cloudflare 的字串值。
+type Variant struct {
+	ID                     string
+	Width                  uint32
+	Height                 uint32
+	Fit                    string
+	Metadata               string
+	NeverRequireSignedURLs bool
+}
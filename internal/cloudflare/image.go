@@ -87,7 +87,7 @@ func GetSignedUrl(ctx context.Context, opts ...imageMetadataOption) (*struct {
 		option.WithEnvironmentProduction())
 	resp, err := service.New(ctx, images.V2DirectUploadNewParams{
 		AccountID:         cloudflare.F(accountID),
-		RequireSignedURLs: cloudflare.F(false),
+		RequireSignedURLs: cloudflare.F(RequireSignedURLs()),
 		Expiry:            cloudflare.F(time.Now().Add(expiryDuration)),
 		Metadata:          cloudflare.F(metadata.ToCoudflareFieldMetadata()),
 	})
@@ -0,0 +1,86 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// 單次清除快取 API 最多可以帶 30 個 URL。
+const maxPurgeFilesPerRequest = 30
+
+// DeliveryURL 將資料庫中的相對變體路徑（/cdn-images/...）轉成 CDN 上的完整 URL，已是完整 URL 時直接回傳。
+// 未設定 cloudflare.delivery_url 而無法轉換時回傳 false。
+func DeliveryURL(path string) (string, bool) {
+	if u, err := url.Parse(path); err == nil && u.IsAbs() {
+		return path, true
+	}
+	base := strings.TrimSuffix(viper.GetString("cloudflare.delivery_url"), "/")
+	if base == "" {
+		return "", false
+	}
+	return base + "/" + strings.TrimPrefix(path, "/"), true
+}
+
+// DeliveryURLs 將資料庫中的相對變體路徑轉成 CDN 上的完整 URL，未設定 cloudflare.delivery_url 時回傳 nil。
+func DeliveryURLs(variants map[string]string) []string {
+	urls := make([]string, 0, len(variants))
+	for _, path := range variants {
+		u, ok := DeliveryURL(path)
+		if !ok {
+			return nil
+		}
+		urls = append(urls, u)
+	}
+	return urls
+}
+
+// PurgeCache 清除 CDN 上指定 URL 的快取，未設定 cloudflare.zone_id 時不做任何事。
+func PurgeCache(ctx context.Context, urls ...string) error {
+	zoneID := viper.GetString("cloudflare.zone_id")
+	if zoneID == "" || len(urls) == 0 {
+		return nil
+	}
+	if err := checkConfig(); err != nil {
+		return err
+	}
+	for start := 0; start < len(urls); start += maxPurgeFilesPerRequest {
+		end := min(start+maxPurgeFilesPerRequest, len(urls))
+		if err := purgeFiles(ctx, zoneID, urls[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func purgeFiles(ctx context.Context, zoneID string, files []string) error {
+	body, err := json.Marshal(map[string][]string{"files": files})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCloudflareCallFailed, err)
+	}
+	url := fmt.Sprintf("https://api.cloudflare.com/client/v4/zones/%s/purge_cache", zoneID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCloudflareCallFailed, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCloudflareCallFailed, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%w: purge cache returned status %d, body: %s", ErrCloudflareCallFailed, resp.StatusCode, string(body))
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/arwoosa/media/internal/cloudflare/dao"
+	cloudflare "github.com/cloudflare/cloudflare-go/v4"
+	images "github.com/cloudflare/cloudflare-go/v4/images"
+	"github.com/cloudflare/cloudflare-go/v4/option"
+)
+
+// UploadImage 由服務端直接上傳圖片內容到 Cloudflare，用於處理後產生的衍生圖片。
+func UploadImage(ctx context.Context, filename, contentType string, data io.Reader, opts ...imageMetadataOption) (*dao.Image, error) {
+	if err := checkConfig(); err != nil {
+		return nil, err
+	}
+	metadata := &dao.ImageMetadata{}
+	for _, opt := range opts {
+		opt(metadata)
+	}
+	service := images.NewV1Service(
+		option.WithAPIToken(apiToken),
+		option.WithEnvironmentProduction(),
+	)
+	resp, err := service.New(ctx, images.V1NewParams{
+		AccountID:         cloudflare.F(accountID),
+		File:              cloudflare.FileParam(data, filename, contentType),
+		Metadata:          cloudflare.F[any](metadata.ToCoudflareFieldMetadata()),
+		RequireSignedURLs: cloudflare.F(RequireSignedURLs()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCloudflareCallFailed, err)
+	}
+	meta := map[string]string{}
+	if m, ok := resp.Meta.(map[string]any); ok {
+		for k, v := range m {
+			if s, ok := v.(string); ok {
+				meta[k] = s
+			}
+		}
+	}
+	if len(meta) == 0 {
+		// 回應沒有帶回 metadata 時使用上傳時送出的內容
+		_ = json.Unmarshal([]byte(metadata.ToCoudflareFieldMetadata().(string)), &meta)
+	}
+	return &dao.Image{
+		ID:       resp.ID,
+		Filename: resp.Filename,
+		Uploaded: resp.Uploaded,
+		Meta:     meta,
+		Variants: resp.Variants,
+	}, nil
+}
@@ -0,0 +1,123 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/arwoosa/media/internal/cloudflare/dao"
+	cloudflare "github.com/cloudflare/cloudflare-go/v4"
+	images "github.com/cloudflare/cloudflare-go/v4/images"
+	"github.com/cloudflare/cloudflare-go/v4/option"
+)
+
+func newVariantService() *images.V1VariantService {
+	return images.NewV1VariantService(
+		option.WithAPIToken(apiToken),
+		option.WithEnvironmentProduction(),
+	)
+}
+
+// CreateVariant 在 Cloudflare 帳號上建立變體。
+func CreateVariant(ctx context.Context, v dao.Variant) error {
+	if err := checkConfig(); err != nil {
+		return err
+	}
+	_, err := newVariantService().New(ctx, images.V1VariantNewParams{
+		AccountID: cloudflare.F(accountID),
+		ID:        cloudflare.F(v.ID),
+		Options: cloudflare.F(images.V1VariantNewParamsOptions{
+			Fit:      cloudflare.F(images.V1VariantNewParamsOptionsFit(v.Fit)),
+			Height:   cloudflare.F(float64(v.Height)),
+			Metadata: cloudflare.F(images.V1VariantNewParamsOptionsMetadata(v.Metadata)),
+			Width:    cloudflare.F(float64(v.Width)),
+		}),
+		NeverRequireSignedURLs: cloudflare.F(v.NeverRequireSignedURLs),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCloudflareCallFailed, err)
+	}
+	return nil
+}
+
+// UpdateVariant 更新 Cloudflare 上既有變體的設定。
+func UpdateVariant(ctx context.Context, v dao.Variant) error {
+	if err := checkConfig(); err != nil {
+		return err
+	}
+	_, err := newVariantService().Edit(ctx, v.ID, images.V1VariantEditParams{
+		AccountID: cloudflare.F(accountID),
+		Options: cloudflare.F(images.V1VariantEditParamsOptions{
+			Fit:      cloudflare.F(images.V1VariantEditParamsOptionsFit(v.Fit)),
+			Height:   cloudflare.F(float64(v.Height)),
+			Metadata: cloudflare.F(images.V1VariantEditParamsOptionsMetadata(v.Metadata)),
+			Width:    cloudflare.F(float64(v.Width)),
+		}),
+		NeverRequireSignedURLs: cloudflare.F(v.NeverRequireSignedURLs),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCloudflareCallFailed, err)
+	}
+	return nil
+}
+
+// DeleteVariant 刪除 Cloudflare 上的變體，使用此變體的 URL 將無法再存取。
+func DeleteVariant(ctx context.Context, id string) error {
+	if err := checkConfig(); err != nil {
+		return err
+	}
+	_, err := newVariantService().Delete(ctx, id, images.V1VariantDeleteParams{
+		AccountID: cloudflare.F(accountID),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCloudflareCallFailed, err)
+	}
+	return nil
+}
+
+// variantListResponse 是列出變體 API 的回應，SDK 的型別只解析 hero 變體，因此自行解析所有變體。
+type variantListResponse struct {
+	Result struct {
+		Variants map[string]struct {
+			ID      string `json:"id"`
+			Options struct {
+				Fit      string  `json:"fit"`
+				Metadata string  `json:"metadata"`
+				Width    float64 `json:"width"`
+				Height   float64 `json:"height"`
+			} `json:"options"`
+			NeverRequireSignedURLs bool `json:"neverRequireSignedURLs"`
+		} `json:"variants"`
+	} `json:"result"`
+}
+
+// ListVariants 依名稱排序列出 Cloudflare 帳號上所有的變體。
+func ListVariants(ctx context.Context) ([]dao.Variant, error) {
+	if err := checkConfig(); err != nil {
+		return nil, err
+	}
+	var resp variantListResponse
+	_, err := newVariantService().List(ctx, images.V1VariantListParams{
+		AccountID: cloudflare.F(accountID),
+	}, option.WithResponseBodyInto(&resp))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCloudflareCallFailed, err)
+	}
+	variants := make([]dao.Variant, 0, len(resp.Result.Variants))
+	for name, v := range resp.Result.Variants {
+		id := v.ID
+		if id == "" {
+			id = name
+		}
+		variants = append(variants, dao.Variant{
+			ID:                     id,
+			Width:                  uint32(v.Options.Width),
+			Height:                 uint32(v.Options.Height),
+			Fit:                    v.Options.Fit,
+			Metadata:               v.Options.Metadata,
+			NeverRequireSignedURLs: v.NeverRequireSignedURLs,
+		})
+	}
+	sort.Slice(variants, func(i, j int) bool { return variants[i].ID < variants[j].ID })
+	return variants, nil
+}
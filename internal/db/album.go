@@ -0,0 +1,326 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	albumpb "github.com/arwoosa/media/internal/pb/album"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(albumCollection)
+}
+
+const AlbumCollectionName = "albums"
+
+var (
+	ErrAlbumNotFound = errors.New("album not found")
+	ErrAlbumChanged  = errors.New("album changed")
+
+	albumCollection = mgo.NewCollectDef(AlbumCollectionName, func() []mongo.IndexModel {
+		return []mongo.IndexModel{
+			{
+				Keys: bson.D{{Key: "owner_id", Value: 1}, {Key: "created_at", Value: -1}},
+			},
+			{
+				Keys: bson.D{{Key: "image_ids", Value: 1}},
+			},
+		}
+	})
+)
+
+type albumOption func(*album)
+
+func WithAlbumOwner(ownerId string) albumOption {
+	return func(a *album) {
+		a.OwnerID = ownerId
+	}
+}
+
+func WithAlbumName(name string) albumOption {
+	return func(a *album) {
+		a.Name = name
+	}
+}
+
+func WithAlbumDescription(description string) albumOption {
+	return func(a *album) {
+		a.Description = description
+	}
+}
+
+func WithAlbumImages(imageIds []string) albumOption {
+	return func(a *album) {
+		a.ImageIDs = uniqueStrings(imageIds)
+	}
+}
+
+// album 是一組有順序的圖片，ImageIDs 存放圖片的 cloudflare id。
+type album struct {
+	mgo.Index    `bson:"-"`
+	ID           bson.ObjectID `bson:"_id,omitempty" validate:"required"`
+	OwnerID      string        `bson:"owner_id" validate:"required"`
+	Name         string        `bson:"name" validate:"required"`
+	Description  string        `bson:"description,omitempty"`
+	CoverImageID string        `bson:"cover_image_id,omitempty"`
+	ImageIDs     []string      `bson:"image_ids"`
+	CreatedAt    time.Time     `bson:"created_at"`
+	UpdatedAt    time.Time     `bson:"updated_at"`
+}
+
+func (a *album) Validate() error {
+	return validate.Struct(a)
+}
+
+func (a *album) GetId() any {
+	return a.ID
+}
+
+func (a *album) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		a.ID = oid
+	}
+}
+
+// HasImage 回傳圖片是否在相簿中。
+func (a *album) HasImage(imageId string) bool {
+	for _, id := range a.ImageIDs {
+		if id == imageId {
+			return true
+		}
+	}
+	return false
+}
+
+// ToProto 將相簿轉成 gRPC 響應使用的格式。
+func (a *album) ToProto() *albumpb.Album {
+	return &albumpb.Album{
+		AlbumId:      a.ID.Hex(),
+		OwnerId:      a.OwnerID,
+		Name:         a.Name,
+		Description:  a.Description,
+		CoverImageId: a.CoverImageID,
+		ImageIds:     a.ImageIDs,
+		CreatedAt:    a.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:    a.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func NewAlbum(opts ...albumOption) *album {
+	now := time.Now().UTC()
+	a := &album{
+		Index:     albumCollection,
+		ID:        bson.NewObjectID(),
+		ImageIDs:  []string{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	if a.CoverImageID == "" && len(a.ImageIDs) > 0 {
+		a.CoverImageID = a.ImageIDs[0]
+	}
+	return a
+}
+
+// uniqueStrings 移除重複的值並保留第一次出現的順序。
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+func albumFilter(albumId string) (bson.D, error) {
+	oid, err := bson.ObjectIDFromHex(albumId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrAlbumNotFound, albumId)
+	}
+	return bson.D{{Key: "_id", Value: oid}}, nil
+}
+
+// FindAlbum 依 ID 查詢相簿，不存在時回傳 ErrAlbumNotFound。
+func FindAlbum(ctx context.Context, albumId string) (*album, error) {
+	filter, err := albumFilter(albumId)
+	if err != nil {
+		return nil, err
+	}
+	a := NewAlbum()
+	err = mgo.FindOne(ctx, a, filter)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrAlbumNotFound, albumId)
+		}
+		return nil, err
+	}
+	return a, nil
+}
+
+// ListAlbumsByOwner 依建立時間由新到舊列出使用者的相簿。
+func ListAlbumsByOwner(ctx context.Context, ownerId string, offset, limit int64) ([]*album, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+	return mgo.Find(ctx, NewAlbum(), bson.D{{Key: "owner_id", Value: ownerId}}, opts)
+}
+
+// updateAlbum 以 filter 條件更新單一相簿並回傳更新後的內容，條件不符時回傳 ErrAlbumChanged。
+func updateAlbum(ctx context.Context, albumId string, cond bson.D, update any) (*album, error) {
+	filter, err := albumFilter(albumId)
+	if err != nil {
+		return nil, err
+	}
+	filter = append(filter, cond...)
+	a := NewAlbum()
+	err = mgo.GetCollection(AlbumCollectionName).
+		FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).
+		Decode(a)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrAlbumChanged, albumId)
+		}
+		return nil, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return a, nil
+}
+
+// RenameAlbum 更新相簿名稱與描述。
+func RenameAlbum(ctx context.Context, albumId, name, description string) (*album, error) {
+	return updateAlbum(ctx, albumId, nil, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "name", Value: name},
+		{Key: "description", Value: description},
+		{Key: "updated_at", Value: time.Now().UTC()},
+	}}})
+}
+
+// AddAlbumImages 將圖片依序加到相簿最後，已存在的圖片維持原位置；相簿沒有封面時以第一張圖片為封面。
+func AddAlbumImages(ctx context.Context, albumId string, imageIds []string) (*album, error) {
+	imageIds = uniqueStrings(imageIds)
+	return updateAlbum(ctx, albumId, nil, mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "image_ids", Value: bson.D{{Key: "$concatArrays", Value: bson.A{
+				"$image_ids",
+				bson.D{{Key: "$filter", Value: bson.D{
+					{Key: "input", Value: imageIds},
+					{Key: "cond", Value: bson.D{{Key: "$not", Value: bson.A{
+						bson.D{{Key: "$in", Value: bson.A{"$$this", "$image_ids"}}},
+					}}}},
+				}}},
+			}}}},
+			{Key: "updated_at", Value: time.Now().UTC()},
+		}}},
+		{{Key: "$set", Value: bson.D{
+			{Key: "cover_image_id", Value: bson.D{{Key: "$ifNull", Value: bson.A{
+				"$cover_image_id",
+				bson.D{{Key: "$first", Value: "$image_ids"}},
+			}}}},
+		}}},
+	})
+}
+
+// removeImagesPipeline 從相簿移除圖片並保留其餘圖片的順序，封面被移除時改用剩下的第一張圖片。
+func removeImagesPipeline(imageIds []string) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "image_ids", Value: bson.D{{Key: "$filter", Value: bson.D{
+				{Key: "input", Value: "$image_ids"},
+				{Key: "cond", Value: bson.D{{Key: "$not", Value: bson.A{
+					bson.D{{Key: "$in", Value: bson.A{"$$this", imageIds}}},
+				}}}},
+			}}}},
+			{Key: "updated_at", Value: time.Now().UTC()},
+		}}},
+		{{Key: "$set", Value: bson.D{
+			{Key: "cover_image_id", Value: bson.D{{Key: "$cond", Value: bson.A{
+				bson.D{{Key: "$in", Value: bson.A{bson.D{{Key: "$ifNull", Value: bson.A{"$cover_image_id", ""}}}, imageIds}}},
+				bson.D{{Key: "$ifNull", Value: bson.A{bson.D{{Key: "$first", Value: "$image_ids"}}, "$$REMOVE"}}},
+				"$cover_image_id",
+			}}}},
+		}}},
+	}
+}
+
+// RemoveAlbumImages 從相簿移除圖片。
+func RemoveAlbumImages(ctx context.Context, albumId string, imageIds []string) (*album, error) {
+	return updateAlbum(ctx, albumId, nil, removeImagesPipeline(imageIds))
+}
+
+// RemoveImagesFromAllAlbums 在圖片被刪除時從所有相簿移除這些圖片。
+func RemoveImagesFromAllAlbums(ctx context.Context, imageIds ...string) error {
+	if len(imageIds) == 0 {
+		return nil
+	}
+	_, err := mgo.GetCollection(AlbumCollectionName).UpdateMany(ctx,
+		bson.D{{Key: "image_ids", Value: bson.D{{Key: "$in", Value: imageIds}}}},
+		removeImagesPipeline(imageIds),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return nil
+}
+
+// ReorderAlbumImages 以新的順序取代相簿圖片，current 為讀取時的順序，期間相簿被修改時回傳 ErrAlbumChanged。
+func ReorderAlbumImages(ctx context.Context, albumId string, current, imageIds []string) (*album, error) {
+	return updateAlbum(ctx, albumId, bson.D{{Key: "image_ids", Value: current}}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "image_ids", Value: imageIds},
+		{Key: "updated_at", Value: time.Now().UTC()},
+	}}})
+}
+
+// SetAlbumCover 設定相簿封面，圖片必須已在相簿中，否則回傳 ErrAlbumChanged。
+func SetAlbumCover(ctx context.Context, albumId, imageId string) (*album, error) {
+	return updateAlbum(ctx, albumId, bson.D{{Key: "image_ids", Value: imageId}}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "cover_image_id", Value: imageId},
+		{Key: "updated_at", Value: time.Now().UTC()},
+	}}})
+}
+
+// DeleteAlbum 刪除相簿，不會刪除相簿中的圖片。
+func DeleteAlbum(ctx context.Context, albumId string) error {
+	filter, err := albumFilter(albumId)
+	if err != nil {
+		return err
+	}
+	n, err := mgo.DeleteMany(ctx, NewAlbum(), filter)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: %s", ErrAlbumNotFound, albumId)
+	}
+	return nil
+}
+
+// IsSamePermutation 檢查 b 是否為 a 的重新排列（不可重複、不可增減）。
+func IsSamePermutation(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		counts[v]--
+		if counts[v] < 0 {
+			return false
+		}
+	}
+	return true
+}
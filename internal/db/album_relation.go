@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arwoosa/vulpes/relation"
+	pb "github.com/ory/keto/proto/ory/keto/relation_tuples/v1alpha2"
+)
+
+const (
+	nsAlbum = "Album"
+)
+
+// SaveAlbumUserOwner 將使用者設為相簿的擁有者。
+func SaveAlbumUserOwner(ctx context.Context, userId, albumId string) error {
+	err := relation.AddUserResourceRole(ctx, userId, nsAlbum, albumId, relation.RoleOwner)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrRelation, err)
+	}
+	return nil
+}
+
+// DeleteAlbumRelation 刪除相簿的所有關係。
+func DeleteAlbumRelation(ctx context.Context, albumId string) error {
+	err := relation.DeleteObjectId(ctx, nsAlbum, albumId)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrRelation, err)
+	}
+	return nil
+}
+
+// CheckAlbumUserPermission 檢查使用者對相簿是否具有指定權限（owner 隱含 editor，editor 隱含 viewer）。
+func CheckAlbumUserPermission(ctx context.Context, userId, albumId, permission string) (bool, error) {
+	ok, err := relation.Check(ctx, nsAlbum, albumId, permission, nsUser, userId)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrRelation, err)
+	}
+	return ok, nil
+}
+
+// LinkAlbumImages 寫入 Image:<image>#viewer@Album:<album>#viewer，
+// 讓相簿的 viewer（包含 editor 與 owner）都能檢視相簿中的圖片，不需要為每個使用者寫入圖片的關係。
+func LinkAlbumImages(ctx context.Context, albumId string, imageIds ...string) error {
+	if len(imageIds) == 0 {
+		return nil
+	}
+	tuples := relation.NewTupleBuilder()
+	for _, id := range imageIds {
+		tuples.AppendInsertTupleWithSubjectSet(nsImage, id, PermissionViewer, nsAlbum, albumId, PermissionViewer)
+	}
+	err := relation.WriteTuple(ctx, tuples)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrRelation, err)
+	}
+	return nil
+}
+
+// UnlinkAlbumImages 刪除 LinkAlbumImages 寫入的繼承關係，圖片的其他關係不受影響。
+func UnlinkAlbumImages(ctx context.Context, albumId string, imageIds ...string) error {
+	if len(imageIds) == 0 {
+		return nil
+	}
+	tuples := relation.NewTupleBuilder()
+	for _, id := range imageIds {
+		// relation.AppendDeleteTupleWithSubjectSet 無法指定 subject relation，因此直接組出完整的 tuple
+		tuples = append(tuples, &pb.RelationTupleDelta{
+			Action: pb.RelationTupleDelta_ACTION_DELETE,
+			RelationTuple: &pb.RelationTuple{
+				Namespace: nsImage,
+				Object:    id,
+				Relation:  PermissionViewer,
+				Subject: &pb.Subject{
+					Ref: &pb.Subject_Set{
+						Set: &pb.SubjectSet{
+							Namespace: nsAlbum,
+							Object:    albumId,
+							Relation:  PermissionViewer,
+						},
+					},
+				},
+			},
+		})
+	}
+	err := relation.WriteTuple(ctx, tuples)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrRelation, err)
+	}
+	return nil
+}
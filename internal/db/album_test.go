@@ -0,0 +1,31 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAlbum(t *testing.T) {
+	a := NewAlbum(
+		WithAlbumOwner("u1"),
+		WithAlbumName("trip"),
+		WithAlbumImages([]string{"a", "b", "a", "c"}),
+	)
+	assert.Equal(t, []string{"a", "b", "c"}, a.ImageIDs)
+	assert.Equal(t, "a", a.CoverImageID)
+	assert.True(t, a.HasImage("b"))
+	assert.False(t, a.HasImage("d"))
+
+	empty := NewAlbum(WithAlbumOwner("u1"), WithAlbumName("empty"))
+	assert.Equal(t, []string{}, empty.ImageIDs)
+	assert.Empty(t, empty.CoverImageID)
+}
+
+func TestIsSamePermutation(t *testing.T) {
+	assert.True(t, IsSamePermutation([]string{"a", "b", "c"}, []string{"c", "a", "b"}))
+	assert.True(t, IsSamePermutation(nil, []string{}))
+	assert.False(t, IsSamePermutation([]string{"a", "b"}, []string{"a", "a"}))
+	assert.False(t, IsSamePermutation([]string{"a", "b"}, []string{"a", "b", "c"}))
+	assert.False(t, IsSamePermutation([]string{"a", "b"}, []string{"a", "d"}))
+}
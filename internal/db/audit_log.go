@@ -0,0 +1,237 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	auditpb "github.com/arwoosa/media/internal/pb/audit"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(auditLogCollection)
+}
+
+const AuditLogCollectionName = "audit_logs"
+
+const (
+	AuditBlocklistMatch  = "blocklist.match"
+	AuditBlocklistAdd    = "blocklist.add"
+	AuditBlocklistDelete = "blocklist.delete"
+	AuditBlocklistLoad   = "blocklist.load"
+
+	AuditTakedownFile    = "takedown.file"
+	AuditTakedownAction  = "takedown.action"
+	AuditTakedownCounter = "takedown.counter_notice"
+	AuditTakedownNotify  = "takedown.notify"
+)
+
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+
+	defaultAuditRetention = 365 * 24 * time.Hour
+)
+
+// AuditRetention 回傳稽核紀錄的保存期限，可由 audit.retention 設定；設為 0 時永久保存。
+// 期限以 TTL index 實作，變更設定後需要先移除既有的 created_at TTL index 才會生效。
+func AuditRetention() time.Duration {
+	if viper.IsSet("audit.retention") {
+		return viper.GetDuration("audit.retention")
+	}
+	return defaultAuditRetention
+}
+
+var auditLogCollection = mgo.NewCollectDef(AuditLogCollectionName, func() []mongo.IndexModel {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "target_ids", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "actor_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "action", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "request_id", Value: 1}},
+		},
+	}
+	if retention := AuditRetention(); retention > 0 {
+		indexes = append(indexes, mongo.IndexModel{
+			Keys:    bson.D{{Key: "created_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(retention / time.Second)),
+		})
+	}
+	return indexes
+})
+
+type auditLogOption func(*auditLog)
+
+func WithAuditAction(action string) auditLogOption {
+	return func(a *auditLog) {
+		a.Action = action
+	}
+}
+
+func WithAuditMethod(method string) auditLogOption {
+	return func(a *auditLog) {
+		a.Method = method
+	}
+}
+
+func WithAuditActor(actorId string) auditLogOption {
+	return func(a *auditLog) {
+		a.ActorID = actorId
+	}
+}
+
+func WithAuditResource(resourceType string, ids ...string) auditLogOption {
+	return func(a *auditLog) {
+		a.ResourceType = resourceType
+		a.TargetIDs = ids
+	}
+}
+
+func WithAuditRequest(requestId, ip string) auditLogOption {
+	return func(a *auditLog) {
+		a.RequestID = requestId
+		a.IP = ip
+	}
+}
+
+// WithAuditOutcome 記錄操作結果，errorCode 為空字串時視為成功。
+func WithAuditOutcome(errorCode string) auditLogOption {
+	return func(a *auditLog) {
+		a.Outcome = AuditSuccess
+		a.ErrorCode = errorCode
+		if errorCode != "" {
+			a.Outcome = AuditFailure
+		}
+	}
+}
+
+func WithAuditDetail(detail map[string]string) auditLogOption {
+	return func(a *auditLog) {
+		a.Detail = detail
+	}
+}
+
+// auditLog 是需要保留追蹤紀錄的操作：所有變更資料的 RPC，以及封鎖清單的命中、下架通知的處理等領域事件。
+type auditLog struct {
+	mgo.Index    `bson:"-"`
+	ID           bson.ObjectID     `bson:"_id,omitempty" validate:"required"`
+	Action       string            `bson:"action" validate:"required"`
+	Method       string            `bson:"method,omitempty"`
+	ActorID      string            `bson:"actor_id,omitempty"`
+	ResourceType string            `bson:"resource_type,omitempty"`
+	TargetIDs    []string          `bson:"target_ids,omitempty"`
+	RequestID    string            `bson:"request_id,omitempty"`
+	IP           string            `bson:"ip,omitempty"`
+	Outcome      string            `bson:"outcome" validate:"required,oneof=success failure"`
+	ErrorCode    string            `bson:"error_code,omitempty"`
+	Detail       map[string]string `bson:"detail,omitempty"`
+	CreatedAt    time.Time         `bson:"created_at"`
+}
+
+func (a *auditLog) Validate() error {
+	return validate.Struct(a)
+}
+
+func (a *auditLog) GetId() any {
+	return a.ID
+}
+
+func (a *auditLog) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		a.ID = oid
+	}
+}
+
+// ToProto 將稽核紀錄轉成 gRPC 響應使用的格式。
+func (a *auditLog) ToProto() *auditpb.AuditLog {
+	return &auditpb.AuditLog{
+		AuditId:      a.ID.Hex(),
+		Action:       a.Action,
+		Method:       a.Method,
+		ActorId:      a.ActorID,
+		ResourceType: a.ResourceType,
+		TargetIds:    a.TargetIDs,
+		RequestId:    a.RequestID,
+		Ip:           a.IP,
+		Outcome:      a.Outcome,
+		ErrorCode:    a.ErrorCode,
+		Detail:       a.Detail,
+		CreatedAt:    a.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func NewAuditLog(opts ...auditLogOption) *auditLog {
+	a := &auditLog{
+		Index:     auditLogCollection,
+		ID:        bson.NewObjectID(),
+		Outcome:   AuditSuccess,
+		CreatedAt: time.Now().UTC(),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// SaveAuditLog 保存稽核紀錄。
+func SaveAuditLog(ctx context.Context, opts ...auditLogOption) error {
+	_, err := mgo.Save(ctx, NewAuditLog(opts...))
+	return err
+}
+
+// AuditLogFilter 是查詢稽核紀錄的條件，零值表示不限。
+type AuditLogFilter struct {
+	ActorID   string
+	Action    string
+	TargetID  string
+	Outcome   string
+	RequestID string
+	Since     time.Time
+	Until     time.Time
+}
+
+func (f AuditLogFilter) filter() bson.D {
+	filter := bson.D{}
+	for _, e := range []bson.E{
+		{Key: "actor_id", Value: f.ActorID},
+		{Key: "action", Value: f.Action},
+		{Key: "target_ids", Value: f.TargetID},
+		{Key: "outcome", Value: f.Outcome},
+		{Key: "request_id", Value: f.RequestID},
+	} {
+		if e.Value != "" {
+			filter = append(filter, e)
+		}
+	}
+	createdAt := bson.D{}
+	if !f.Since.IsZero() {
+		createdAt = append(createdAt, bson.E{Key: "$gte", Value: f.Since})
+	}
+	if !f.Until.IsZero() {
+		createdAt = append(createdAt, bson.E{Key: "$lt", Value: f.Until})
+	}
+	if len(createdAt) > 0 {
+		filter = append(filter, bson.E{Key: "created_at", Value: createdAt})
+	}
+	return filter
+}
+
+// QueryAuditLogs 依建立時間由新到舊查詢稽核紀錄。
+func QueryAuditLogs(ctx context.Context, f AuditLogFilter, offset, limit int64) ([]*auditLog, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+	return mgo.Find(ctx, NewAuditLog(), f.filter(), opts)
+}
@@ -0,0 +1,48 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestNewAuditLog(t *testing.T) {
+	a := NewAuditLog(
+		WithAuditAction("image.delete"),
+		WithAuditActor("u1"),
+		WithAuditResource("image", "img-1", "img-2"),
+		WithAuditRequest("req-1", "1.2.3.4"),
+		WithAuditOutcome("NotFound"))
+	assert.NoError(t, a.Validate())
+	assert.Equal(t, AuditFailure, a.Outcome)
+
+	pb := a.ToProto()
+	assert.Equal(t, a.ID.Hex(), pb.GetAuditId())
+	assert.Equal(t, []string{"img-1", "img-2"}, pb.GetTargetIds())
+	assert.Equal(t, "req-1", pb.GetRequestId())
+	assert.Equal(t, "NotFound", pb.GetErrorCode())
+
+	assert.Equal(t, AuditSuccess, NewAuditLog(WithAuditAction("image.delete"), WithAuditOutcome("")).Outcome)
+}
+
+func TestAuditLogFilter(t *testing.T) {
+	assert.Equal(t, bson.D{}, AuditLogFilter{}.filter())
+
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, bson.D{
+		{Key: "actor_id", Value: "u1"},
+		{Key: "target_ids", Value: "img-1"},
+		{Key: "created_at", Value: bson.D{{Key: "$gte", Value: since}}},
+	}, AuditLogFilter{ActorID: "u1", TargetID: "img-1", Since: since}.filter())
+}
+
+func TestAuditRetention(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	assert.Equal(t, defaultAuditRetention, AuditRetention())
+	viper.Set("audit.retention", "0")
+	assert.Equal(t, time.Duration(0), AuditRetention())
+}
@@ -0,0 +1,265 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/media/internal/imagehash"
+	blocklistpb "github.com/arwoosa/media/internal/pb/blocklist"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(blocklistCollection)
+}
+
+const BlocklistCollectionName = "hash_blocklist"
+
+var (
+	ErrBlockedHashNotFound = errors.New("blocked hash not found")
+	ErrBlockedHashExists   = errors.New("blocked hash already exists")
+)
+
+var blocklistCollection = mgo.NewCollectDef(BlocklistCollectionName, func() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "type", Value: 1}, {Key: "hash", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "created_at", Value: -1}},
+		},
+	}
+})
+
+type blockedHashOption func(*blockedHash)
+
+func WithBlockedHash(hashType, hash string) blockedHashOption {
+	return func(b *blockedHash) {
+		b.Type = hashType
+		b.Hash = hash
+	}
+}
+
+func WithBlockedHashReason(reason, source string) blockedHashOption {
+	return func(b *blockedHash) {
+		b.Reason = reason
+		b.Source = source
+	}
+}
+
+func WithBlockedHashCreator(creatorId string) blockedHashOption {
+	return func(b *blockedHash) {
+		b.CreatedBy = creatorId
+	}
+}
+
+// blockedHash 是禁止上傳的圖片雜湊，Type 為 sha256（完全相同）或 phash（感知雜湊，相近即命中）。
+type blockedHash struct {
+	mgo.Index `bson:"-"`
+	ID        bson.ObjectID `bson:"_id,omitempty" validate:"required"`
+	Type      string        `bson:"type" validate:"required,oneof=sha256 phash"`
+	Hash      string        `bson:"hash" validate:"required"`
+	Reason    string        `bson:"reason,omitempty"`
+	Source    string        `bson:"source,omitempty"`
+	CreatedBy string        `bson:"created_by,omitempty"`
+	CreatedAt time.Time     `bson:"created_at"`
+}
+
+func (b *blockedHash) Validate() error {
+	return validate.Struct(b)
+}
+
+func (b *blockedHash) GetId() any {
+	return b.ID
+}
+
+func (b *blockedHash) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		b.ID = oid
+	}
+}
+
+// ToProto 將封鎖的雜湊轉成 gRPC 響應使用的格式。
+func (b *blockedHash) ToProto() *blocklistpb.BlockedHash {
+	return &blocklistpb.BlockedHash{
+		HashId:    b.ID.Hex(),
+		Type:      b.Type,
+		Hash:      b.Hash,
+		Reason:    b.Reason,
+		Source:    b.Source,
+		CreatedBy: b.CreatedBy,
+		CreatedAt: b.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func NewBlockedHash(opts ...blockedHashOption) *blockedHash {
+	b := &blockedHash{
+		Index:     blocklistCollection,
+		ID:        bson.NewObjectID(),
+		CreatedAt: time.Now().UTC(),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// SaveBlockedHash 新增封鎖的雜湊，相同的雜湊已存在時回傳 ErrBlockedHashExists。
+func SaveBlockedHash(ctx context.Context, b *blockedHash) error {
+	_, err := mgo.Save(ctx, b)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%w: %s:%s", ErrBlockedHashExists, b.Type, b.Hash)
+		}
+		return err
+	}
+	return nil
+}
+
+// loadBatchSize 是批次匯入雜湊時每次寫入的數量。
+const loadBatchSize = 1000
+
+// LoadBlockedHashes 批次新增封鎖的雜湊，opts 套用到每一筆雜湊；已存在的雜湊會被略過，回傳實際新增的數量。
+func LoadBlockedHashes(ctx context.Context, entries []imagehash.Entry, opts ...blockedHashOption) (int64, error) {
+	var inserted int64
+	for start := 0; start < len(entries); start += loadBatchSize {
+		end := min(start+loadBatchSize, len(entries))
+		models := make([]mongo.WriteModel, 0, end-start)
+		for _, e := range entries[start:end] {
+			b := NewBlockedHash(opts...)
+			b.Type, b.Hash = e.Type, e.Hash
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.D{{Key: "type", Value: b.Type}, {Key: "hash", Value: b.Hash}}).
+				SetUpdate(bson.D{{Key: "$setOnInsert", Value: b}}).
+				SetUpsert(true))
+		}
+		result, err := mgo.GetCollection(BlocklistCollectionName).
+			BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+		if err != nil {
+			return inserted, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+		}
+		inserted += result.UpsertedCount
+	}
+	return inserted, nil
+}
+
+// ListBlockedHashes 依新增時間由新到舊列出封鎖的雜湊，hashType 為空字串時不限類型。
+func ListBlockedHashes(ctx context.Context, hashType string, offset, limit int64) ([]*blockedHash, error) {
+	filter := bson.D{}
+	if hashType != "" {
+		filter = append(filter, bson.E{Key: "type", Value: hashType})
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+	return mgo.Find(ctx, NewBlockedHash(), filter, opts)
+}
+
+// DeleteBlockedHash 刪除封鎖的雜湊，回傳被刪除的資料。
+func DeleteBlockedHash(ctx context.Context, id string) (*blockedHash, error) {
+	oid, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrBlockedHashNotFound, id)
+	}
+	deleted := NewBlockedHash()
+	err = mgo.GetCollection(BlocklistCollectionName).
+		FindOneAndDelete(ctx, bson.D{{Key: "_id", Value: oid}}).
+		Decode(deleted)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrBlockedHashNotFound, id)
+		}
+		return nil, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return deleted, nil
+}
+
+// BlocklistMatch 是圖片命中封鎖清單的結果，Distance 為感知雜湊的漢明距離（SHA-256 命中時為 0）。
+type BlocklistMatch struct {
+	HashID   string
+	Type     string
+	Hash     string
+	Reason   string
+	Distance int
+}
+
+// BlockedPHashes 是預先載入並解析的感知雜湊封鎖清單，一次上傳的多張圖片共用同一份，避免逐張查詢。
+type BlockedPHashes struct {
+	hashes []blockedPHash
+}
+
+type blockedPHash struct {
+	hash  uint64
+	entry *blockedHash
+}
+
+// LoadBlockedPHashes 載入所有感知雜湊，無法解析的雜湊會被略過。
+func LoadBlockedPHashes(ctx context.Context) (*BlockedPHashes, error) {
+	entries, err := mgo.Find(ctx, NewBlockedHash(), bson.D{{Key: "type", Value: imagehash.TypePHash}})
+	if err != nil {
+		return nil, err
+	}
+	return newBlockedPHashes(entries), nil
+}
+
+func newBlockedPHashes(entries []*blockedHash) *BlockedPHashes {
+	set := &BlockedPHashes{hashes: make([]blockedPHash, 0, len(entries))}
+	for _, e := range entries {
+		h, err := imagehash.ParsePHash(e.Hash)
+		if err != nil {
+			continue
+		}
+		set.hashes = append(set.hashes, blockedPHash{hash: h, entry: e})
+	}
+	return set
+}
+
+// Closest 回傳漢明距離最小且不超過 maxDistance 的感知雜湊，沒有命中時回傳 nil。
+func (s *BlockedPHashes) Closest(phash uint64, maxDistance int) *BlocklistMatch {
+	if s == nil {
+		return nil
+	}
+	var best *BlocklistMatch
+	for _, c := range s.hashes {
+		d := imagehash.Distance(c.hash, phash)
+		if d > maxDistance || (best != nil && d >= best.Distance) {
+			continue
+		}
+		best = &BlocklistMatch{HashID: c.entry.ID.Hex(), Type: c.entry.Type, Hash: c.entry.Hash, Reason: c.entry.Reason, Distance: d}
+	}
+	return best
+}
+
+// MatchBlockedHash 比對內容的 SHA-256 與感知雜湊，phash 為 nil 時只比對 SHA-256；沒有命中時回傳 nil。
+// 感知雜湊以呼叫端預先載入的 phashes 比對。
+func MatchBlockedHash(ctx context.Context, sha256 string, phash *uint64, phashes *BlockedPHashes, maxDistance int) (*BlocklistMatch, error) {
+	exact := NewBlockedHash()
+	err := mgo.FindOne(ctx, exact, bson.D{{Key: "type", Value: imagehash.TypeSHA256}, {Key: "hash", Value: sha256}})
+	if err == nil {
+		return &BlocklistMatch{HashID: exact.ID.Hex(), Type: exact.Type, Hash: exact.Hash, Reason: exact.Reason}, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	if phash == nil {
+		return nil, nil
+	}
+	return phashes.Closest(*phash, maxDistance), nil
+}
+
+// HasBlockedHashes 判斷封鎖清單是否有資料，清單為空時上傳不需要下載原圖比對。
+func HasBlockedHashes(ctx context.Context) (bool, error) {
+	n, err := mgo.GetCollection(BlocklistCollectionName).EstimatedDocumentCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,35 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/arwoosa/media/internal/imagehash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockedPHashesClosest(t *testing.T) {
+	far := NewBlockedHash(WithBlockedHash(imagehash.TypePHash, "ffffffffffffffff"))
+	near := NewBlockedHash(WithBlockedHash(imagehash.TypePHash, "0000000000000003"), WithBlockedHashReason("csam", "ncmec"))
+	nearer := NewBlockedHash(WithBlockedHash(imagehash.TypePHash, "0000000000000001"))
+	invalid := NewBlockedHash(WithBlockedHash(imagehash.TypePHash, "zz"))
+
+	var empty *BlockedPHashes
+	assert.Nil(t, empty.Closest(0, 6))
+	assert.Nil(t, newBlockedPHashes(nil).Closest(0, 6))
+	assert.Nil(t, newBlockedPHashes([]*blockedHash{far, invalid}).Closest(0, 6))
+	assert.Len(t, newBlockedPHashes([]*blockedHash{far, invalid}).hashes, 1)
+
+	match := newBlockedPHashes([]*blockedHash{far, near}).Closest(0, 6)
+	assert.Equal(t, near.ID.Hex(), match.HashID)
+	assert.Equal(t, 2, match.Distance)
+	assert.Equal(t, "csam", match.Reason)
+
+	match = newBlockedPHashes([]*blockedHash{near, nearer, far}).Closest(0, 6)
+	assert.Equal(t, nearer.ID.Hex(), match.HashID)
+	assert.Equal(t, 1, match.Distance)
+}
+
+func TestBlockedHashValidate(t *testing.T) {
+	assert.NoError(t, NewBlockedHash(WithBlockedHash(imagehash.TypeSHA256, imagehash.SHA256([]byte("x")))).Validate())
+	assert.Error(t, NewBlockedHash(WithBlockedHash("md5", "abc")).Validate())
+}
@@ -20,6 +20,38 @@ func ToStatus(err error) *status.Status {
 	switch {
 	case errors.Is(err, ErrRelation):
 		return relation.ToStatus(err)
+	case errors.Is(err, ErrAlbumNotFound):
+		return status.New(codes.NotFound, err.Error())
+	case errors.Is(err, ErrAlbumChanged):
+		return status.New(codes.Aborted, err.Error())
+	case errors.Is(err, ErrImageNotFound), errors.Is(err, ErrReferenceNotFound):
+		return status.New(codes.NotFound, err.Error())
+	case errors.Is(err, ErrInvalidImageInfoField):
+		return status.New(codes.InvalidArgument, err.Error())
+	case errors.Is(err, ErrImageVersionNotFound):
+		return status.New(codes.NotFound, err.Error())
+	case errors.Is(err, ErrImageChanged):
+		return status.New(codes.Aborted, err.Error())
+	case errors.Is(err, ErrNoPendingReplacement):
+		return status.New(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrVariantNotFound):
+		return status.New(codes.NotFound, err.Error())
+	case errors.Is(err, ErrVariantExists):
+		return status.New(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ErrWatermarkNotFound):
+		return status.New(codes.NotFound, err.Error())
+	case errors.Is(err, ErrBlockedHashNotFound):
+		return status.New(codes.NotFound, err.Error())
+	case errors.Is(err, ErrBlockedHashExists):
+		return status.New(codes.AlreadyExists, err.Error())
+	case errors.Is(err, ErrTakedownNotFound):
+		return status.New(codes.NotFound, err.Error())
+	case errors.Is(err, ErrTakedownStatus):
+		return status.New(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, ErrModerationClaimed):
+		return status.New(codes.Aborted, err.Error())
+	case errors.Is(err, ErrModerationNotPending), errors.Is(err, ErrModerationTakedown):
+		return status.New(codes.FailedPrecondition, err.Error())
 	default:
 		unwrapErr := errors.Unwrap(err)
 		if unwrapErr == nil {
@@ -1,7 +1,11 @@
 package db
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -31,6 +35,36 @@ var (
 			{
 				Keys: bson.D{{Key: "location", Value: "2dsphere"}},
 			},
+			{
+				Keys: bson.D{{Key: "owner_id", Value: 1}},
+			},
+			{
+				Keys: bson.D{{Key: "tags", Value: 1}},
+			},
+			{
+				Keys: bson.D{{Key: "uploaded", Value: -1}, {Key: "_id", Value: -1}},
+			},
+			{
+				Keys:    imageTextIndexKeys,
+				Options: imageTextIndexOptions(),
+			},
+			{
+				Keys: bson.D{
+					{Key: "references.service", Value: 1},
+					{Key: "references.entity_type", Value: 1},
+					{Key: "references.entity_id", Value: 1},
+				},
+			},
+			{
+				Keys: bson.D{{Key: "source_image_id", Value: 1}},
+			},
+			{
+				Keys: bson.D{
+					{Key: "moderation.status", Value: 1},
+					{Key: "report_count", Value: -1},
+					{Key: "uploaded", Value: 1},
+				},
+			},
 		}
 	})
 )
@@ -58,6 +92,7 @@ func WithImageUploaded(uploaded time.Time) imageOption {
 func WithImageMeta(meta map[string]string) imageOption {
 	return func(i *image) {
 		i.Meta = meta
+		i.MetaValues = metaValues(meta)
 	}
 }
 
@@ -129,6 +164,40 @@ func WithSize(size uint64) imageOption {
 	}
 }
 
+func WithImageOwner(ownerId string) imageOption {
+	return func(i *image) {
+		i.OwnerID = ownerId
+	}
+}
+
+func WithImageInfo(info ImageInfo) imageOption {
+	return func(i *image) {
+		info.Tags = NormalizeTags(info.Tags)
+		i.ImageInfo = info
+	}
+}
+
+// WithImageSource 記錄衍生圖片的來源圖片。
+func WithImageSource(sourceImageId string) imageOption {
+	return func(i *image) {
+		i.SourceImageID = sourceImageId
+	}
+}
+
+// WithImageUsageCounted 標記圖片建立時已同時計入擁有者的用量。
+func WithImageUsageCounted() imageOption {
+	return func(i *image) {
+		i.UsageCounted = true
+	}
+}
+
+// WithImageWatermark 記錄已寫入圖片內容的浮水印。
+func WithImageWatermark(applied *AppliedWatermark) imageOption {
+	return func(i *image) {
+		i.Watermark = applied
+	}
+}
+
 type image struct {
 	mgo.Index    `bson:"-"`
 	ID           bson.ObjectID   `bson:"_id,omitempty" validate:"required"`
@@ -137,10 +206,34 @@ type image struct {
 	Uploaded     time.Time       `bson:"uploaded,omitempty" validate:"required"`
 	Size         uint64          `bson:"size,omitempty" validate:"required"`
 	Location     *types.Location `bson:"location,omitempty"`
+	OwnerID      string          `bson:"owner_id,omitempty"`
+	ImageInfo    `bson:",inline"`
+
+	Meta       map[string]string `bson:"meta,omitempty"`
+	MetaValues []string          `bson:"meta_values,omitempty"` // Meta 的值，供全文檢索使用
+	Variants   map[string]string `bson:"variants,omitempty" validate:"required"`
+	Count      int               `bson:"count,omitempty"`
+	References []ImageReference  `bson:"references,omitempty"`
+	// SourceImageID 是處理後產生的衍生圖片的來源圖片 cloudflare id。
+	SourceImageID string `bson:"source_image_id,omitempty"`
+	// Watermark 是已寫入圖片內容的浮水印，傳遞時不會再疊加浮水印。
+	Watermark *AppliedWatermark `bson:"watermark,omitempty"`
+	// ReportCount 是圖片被檢舉的次數，Hidden 為 true 時只有擁有者能取得圖片。
+	ReportCount  int       `bson:"report_count,omitempty"`
+	Hidden       bool      `bson:"hidden,omitempty"`
+	HiddenAt     time.Time `bson:"hidden_at,omitempty"`
+	HiddenReason string    `bson:"hidden_reason,omitempty"`
+	// Moderation 是圖片的審核狀態，沒有審核狀態的舊圖片視為已核准。
+	Moderation *Moderation `bson:"moderation,omitempty"`
+
+	// ProviderID 是目前內容在 Cloudflare 上的 ID，更換內容後與對外固定的 CloudflareID 不同。
+	ProviderID  string            `bson:"provider_id,omitempty"`
+	Version     int               `bson:"version,omitempty"`
+	History     []ImageVersion    `bson:"history,omitempty"`
+	Replacement *ImageReplacement `bson:"replacement,omitempty"`
 
-	Meta     map[string]string `bson:"meta,omitempty"`
-	Variants map[string]string `bson:"variants,omitempty" validate:"required"`
-	Count    int               `bson:"count,omitempty"`
+	// UsageCounted 表示圖片已計入擁有者的用量，加入用量統計前建立的圖片由 BackfillStorageUsage 補上。
+	UsageCounted bool `bson:"usage_counted,omitempty"`
 }
 
 func (i *image) Validate() error {
@@ -158,6 +251,13 @@ func (i *image) SetId(id any) {
 	}
 }
 
+// Dimensions 回傳上傳時記錄在 Meta 中的寬高，未記錄時為 0。
+func (i *image) Dimensions() (uint32, uint32) {
+	width, _ := strconv.ParseUint(i.Meta["width"], 10, 32)
+	height, _ := strconv.ParseUint(i.Meta["height"], 10, 32)
+	return uint32(width), uint32(height)
+}
+
 func NewImage(opts ...imageOption) *image {
 	i := &image{
 		Index: imageCollection,
@@ -170,3 +270,32 @@ func NewImage(opts ...imageOption) *image {
 
 	return i
 }
+
+// FindImagesByCloudflareIDs 依 cloudflare id 批次查詢圖片，回傳以 cloudflare id 為 key 的 map。
+func FindImagesByCloudflareIDs(ctx context.Context, ids []string) (map[string]*image, error) {
+	result := make(map[string]*image, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+	images, err := mgo.Find(ctx, NewImage(), bson.M{"cloudflare_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, err
+	}
+	for _, img := range images {
+		result[img.CloudflareID] = img
+	}
+	return result, nil
+}
+
+// FindImage 依 cloudflare id 查詢圖片，不存在時回傳 ErrImageNotFound。
+func FindImage(ctx context.Context, imageId string) (*image, error) {
+	img := NewImage()
+	err := mgo.FindOne(ctx, img, bson.D{{Key: "cloudflare_id", Value: imageId}})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrImageNotFound, imageId)
+		}
+		return nil, err
+	}
+	return img, nil
+}
@@ -1,4 +1,61 @@
 package db
 
-type ImageCount struct {
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// IncImageCounts 以 unordered bulk $inc 將瀏覽次數累加到 images.count。
+// 回傳已成功套用（或圖片已不存在而無需套用）的 cloudflare id；
+// 部分失敗時同時回傳錯誤，呼叫端應保留未回傳的計數稍後重試。
+func IncImageCounts(ctx context.Context, counts map[string]int64) ([]string, error) {
+	ids := make([]string, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	models := make([]mongo.WriteModel, 0, len(ids))
+	modelIds := make([]string, 0, len(ids))
+	applied := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if counts[id] <= 0 {
+			applied = append(applied, id)
+			continue
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "cloudflare_id", Value: id}}).
+			SetUpdate(bson.D{{Key: "$inc", Value: bson.D{{Key: "count", Value: counts[id]}}}}))
+		modelIds = append(modelIds, id)
+	}
+	if len(models) == 0 {
+		return applied, nil
+	}
+
+	_, err := mgo.GetCollection(ImageCollectionName).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err == nil {
+		return append(applied, modelIds...), nil
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || len(bulkErr.WriteErrors) == 0 {
+		return applied, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	failed := make(map[int]struct{}, len(bulkErr.WriteErrors))
+	for _, we := range bulkErr.WriteErrors {
+		failed[we.Index] = struct{}{}
+	}
+	for i, id := range modelIds {
+		if _, ok := failed[i]; !ok {
+			applied = append(applied, id)
+		}
+	}
+	return applied, fmt.Errorf("%w: %d of %d image counts failed: %w", mgo.ErrWriteFailed, len(failed), len(models), err)
 }
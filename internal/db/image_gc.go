@@ -0,0 +1,64 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// unusedImageFilter 是回收候選圖片的條件：
+// 只考慮 trackedSince 之後上傳（引用追蹤啟用後）、早於 uploadedBefore 的圖片，
+// 並排除仍被引用、等待更換內容、被檢舉隱藏，以及審核狀態不是已核准的圖片（待審、保留、下架等）。
+func unusedImageFilter(trackedSince, uploadedBefore time.Time) bson.D {
+	return bson.D{
+		{Key: "uploaded", Value: bson.D{{Key: "$gte", Value: trackedSince}, {Key: "$lt", Value: uploadedBefore}}},
+		{Key: "references.0", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "replacement", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "hidden", Value: bson.D{{Key: "$ne", Value: true}}},
+		{Key: "$or", Value: bson.A{
+			bson.D{{Key: "moderation", Value: bson.D{{Key: "$exists", Value: false}}}},
+			bson.D{{Key: "moderation.status", Value: ModerationApproved}},
+		}},
+	}
+}
+
+// FindUnusedImages 依上傳時間由舊到新找出符合 unusedImageFilter、不在任何相簿中，
+// 也不是其他衍生圖片來源的圖片。
+func FindUnusedImages(ctx context.Context, trackedSince, uploadedBefore time.Time, limit int64) ([]*image, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: unusedImageFilter(trackedSince, uploadedBefore)}},
+		{{Key: "$sort", Value: bson.D{{Key: "uploaded", Value: 1}}}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: AlbumCollectionName},
+			{Key: "localField", Value: "cloudflare_id"},
+			{Key: "foreignField", Value: "image_ids"},
+			{Key: "as", Value: "albums"},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "albums", Value: bson.D{{Key: "$size", Value: 0}}}}}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: ImageCollectionName},
+			{Key: "localField", Value: "cloudflare_id"},
+			{Key: "foreignField", Value: "source_image_id"},
+			{Key: "as", Value: "derived"},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "derived", Value: bson.D{{Key: "$size", Value: 0}}}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: bson.D{{Key: "albums", Value: 0}, {Key: "derived", Value: 0}}}},
+	}
+	cursor, err := mgo.GetCollection(ImageCollectionName).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+	}
+	var images []*image
+	if err := cursor.All(ctx, &images); err != nil {
+		return nil, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+	}
+	for _, img := range images {
+		img.Index = imageCollection
+	}
+	return images, nil
+}
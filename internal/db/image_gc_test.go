@@ -0,0 +1,28 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestUnusedImageFilter(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	filter := unusedImageFilter(since, before)
+
+	fields := map[string]any{}
+	for _, e := range filter {
+		fields[e.Key] = e.Value
+	}
+	assert.Equal(t, bson.D{{Key: "$gte", Value: since}, {Key: "$lt", Value: before}}, fields["uploaded"])
+	assert.Equal(t, bson.D{{Key: "$exists", Value: false}}, fields["replacement"])
+	assert.Equal(t, bson.D{{Key: "$ne", Value: true}}, fields["hidden"])
+	// 只有沒有審核狀態或已核准的圖片可以回收
+	assert.Equal(t, bson.A{
+		bson.D{{Key: "moderation", Value: bson.D{{Key: "$exists", Value: false}}}},
+		bson.D{{Key: "moderation.status", Value: ModerationApproved}},
+	}, fields["$or"])
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var ErrInvalidImageInfoField = errors.New("invalid image info field")
+
+// ImageInfo 是使用者可編輯的圖片資訊，與 Cloudflare 複製過來的 Meta 分開存放。
+type ImageInfo struct {
+	Title       string   `bson:"title,omitempty"`
+	Description string   `bson:"description,omitempty"`
+	AltText     string   `bson:"alt_text,omitempty"`
+	Copyright   string   `bson:"copyright,omitempty"`
+	License     string   `bson:"license,omitempty"`
+	Tags        []string `bson:"tags,omitempty"`
+}
+
+// imageInfoFields 將 FieldMask 的路徑對應到 ImageInfo 的值。
+var imageInfoFields = map[string]func(ImageInfo) any{
+	"title":       func(i ImageInfo) any { return i.Title },
+	"description": func(i ImageInfo) any { return i.Description },
+	"alt_text":    func(i ImageInfo) any { return i.AltText },
+	"copyright":   func(i ImageInfo) any { return i.Copyright },
+	"license":     func(i ImageInfo) any { return i.License },
+	"tags":        func(i ImageInfo) any { return NormalizeTags(i.Tags) },
+}
+
+// NormalizeTags 將標籤轉成小寫並移除空白與重複的標籤。
+func NormalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	normalized := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if t = strings.ToLower(strings.TrimSpace(t)); t != "" {
+			normalized = append(normalized, t)
+		}
+	}
+	return uniqueStrings(normalized)
+}
+
+// imageInfoUpdate 依 paths 組出更新語法，空字串與空標籤會移除欄位。
+func imageInfoUpdate(info ImageInfo, paths []string) (bson.D, error) {
+	set, unset := bson.D{}, bson.D{}
+	for _, p := range uniqueStrings(paths) {
+		value, ok := imageInfoFields[p]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidImageInfoField, p)
+		}
+		switch v := value(info).(type) {
+		case string:
+			if v == "" {
+				unset = append(unset, bson.E{Key: p, Value: ""})
+				continue
+			}
+			set = append(set, bson.E{Key: p, Value: v})
+		case []string:
+			if len(v) == 0 {
+				unset = append(unset, bson.E{Key: p, Value: ""})
+				continue
+			}
+			set = append(set, bson.E{Key: p, Value: v})
+		}
+	}
+	update := bson.D{}
+	if len(set) > 0 {
+		update = append(update, bson.E{Key: "$set", Value: set})
+	}
+	if len(unset) > 0 {
+		update = append(update, bson.E{Key: "$unset", Value: unset})
+	}
+	return update, nil
+}
+
+// UpdateImageInfo 只更新 paths 指定的圖片資訊欄位，回傳更新後的圖片。
+func UpdateImageInfo(ctx context.Context, imageId string, info ImageInfo, paths []string) (*image, error) {
+	update, err := imageInfoUpdate(info, paths)
+	if err != nil {
+		return nil, err
+	}
+	if len(update) == 0 {
+		return FindImage(ctx, imageId)
+	}
+	img := NewImage()
+	err = mgo.GetCollection(ImageCollectionName).
+		FindOneAndUpdate(ctx, bson.D{{Key: "cloudflare_id", Value: imageId}}, update,
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).
+		Decode(img)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrImageNotFound, imageId)
+		}
+		return nil, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return img, nil
+}
@@ -0,0 +1,28 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	assert.Nil(t, NormalizeTags(nil))
+	assert.Equal(t, []string{"taipei", "night market"}, NormalizeTags([]string{" Taipei", "night market", "TAIPEI", " "}))
+}
+
+func TestImageInfoUpdate(t *testing.T) {
+	info := ImageInfo{Title: "Sunset", Tags: []string{"Beach"}}
+
+	update, err := imageInfoUpdate(info, []string{"title", "description", "tags", "title"})
+	assert.NoError(t, err)
+	assert.Equal(t, bson.D{
+		{Key: "$set", Value: bson.D{{Key: "title", Value: "Sunset"}, {Key: "tags", Value: []string{"beach"}}}},
+		{Key: "$unset", Value: bson.D{{Key: "description", Value: ""}}},
+	}, update)
+
+	_, err = imageInfoUpdate(info, []string{"owner_id"})
+	assert.True(t, errors.Is(err, ErrInvalidImageInfoField))
+}
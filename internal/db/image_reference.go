@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+var (
+	ErrImageNotFound     = errors.New("image not found")
+	ErrReferenceNotFound = errors.New("image reference not found")
+)
+
+// ImageReference 記錄圖片被其他服務的哪個實體使用，例如 event 服務的活動封面。
+type ImageReference struct {
+	Service    string    `bson:"service"`
+	EntityType string    `bson:"entity_type"`
+	EntityID   string    `bson:"entity_id"`
+	AttachedBy string    `bson:"attached_by,omitempty"`
+	CreatedAt  time.Time `bson:"created_at"`
+}
+
+func (r ImageReference) key() bson.D {
+	return bson.D{
+		{Key: "service", Value: r.Service},
+		{Key: "entity_type", Value: r.EntityType},
+		{Key: "entity_id", Value: r.EntityID},
+	}
+}
+
+// AttachImageReference 新增圖片的引用，相同的 (service, entity type, entity id) 只會記錄一次。
+func AttachImageReference(ctx context.Context, imageId string, ref ImageReference) error {
+	if ref.CreatedAt.IsZero() {
+		ref.CreatedAt = time.Now().UTC()
+	}
+	result, err := mgo.GetCollection(ImageCollectionName).UpdateOne(ctx,
+		bson.D{
+			{Key: "cloudflare_id", Value: imageId},
+			{Key: "references", Value: bson.D{{Key: "$not", Value: bson.D{{Key: "$elemMatch", Value: ref.key()}}}}},
+		},
+		bson.D{{Key: "$push", Value: bson.D{{Key: "references", Value: ref}}}},
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+	// 沒有更新時，可能是引用已存在或圖片不存在
+	err = mgo.FindOne(ctx, NewImage(), bson.D{{Key: "cloudflare_id", Value: imageId}})
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return fmt.Errorf("%w: %s", ErrImageNotFound, imageId)
+	}
+	return err
+}
+
+// DetachImageReference 移除圖片的引用，引用不存在時回傳 ErrReferenceNotFound。
+func DetachImageReference(ctx context.Context, imageId string, ref ImageReference) error {
+	result, err := mgo.GetCollection(ImageCollectionName).UpdateOne(ctx,
+		bson.D{{Key: "cloudflare_id", Value: imageId}},
+		bson.D{{Key: "$pull", Value: bson.D{{Key: "references", Value: ref.key()}}}},
+	)
+	if err != nil {
+		return fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("%w: %s", ErrImageNotFound, imageId)
+	}
+	if result.ModifiedCount == 0 {
+		return fmt.Errorf("%w: %s/%s/%s", ErrReferenceNotFound, ref.Service, ref.EntityType, ref.EntityID)
+	}
+	return nil
+}
+
+// FindReference 回傳圖片中與 ref 相同實體的引用。
+func (i *image) FindReference(ref ImageReference) (ImageReference, bool) {
+	for _, r := range i.References {
+		if r.Service == ref.Service && r.EntityType == ref.EntityType && r.EntityID == ref.EntityID {
+			return r, true
+		}
+	}
+	return ImageReference{}, false
+}
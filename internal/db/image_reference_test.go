@@ -0,0 +1,22 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageFindReference(t *testing.T) {
+	img := NewImage()
+	img.References = []ImageReference{
+		{Service: "event", EntityType: "event_cover", EntityID: "e1", AttachedBy: "u1"},
+		{Service: "community", EntityType: "post", EntityID: "p1", AttachedBy: "u2"},
+	}
+
+	ref, ok := img.FindReference(ImageReference{Service: "community", EntityType: "post", EntityID: "p1"})
+	assert.True(t, ok)
+	assert.Equal(t, "u2", ref.AttachedBy)
+
+	_, ok = img.FindReference(ImageReference{Service: "event", EntityType: "post", EntityID: "e1"})
+	assert.False(t, ok)
+}
@@ -9,6 +9,11 @@ import (
 
 const (
 	nsImage = "Image"
+	nsUser  = "User"
+
+	PermissionOwner  = "owner"
+	PermissionEditor = "editor"
+	PermissionViewer = "viewer"
 )
 
 func SaveImageUserOwner(ctx context.Context, userId string, imageIds []string) error {
@@ -33,3 +38,25 @@ func DeleteImageUserRelation(ctx context.Context, imageIds ...string) error {
 	}
 	return nil
 }
+
+// CheckImageUserPermission 檢查使用者對圖片是否具有指定權限（owner 隱含 editor，editor 隱含 viewer）。
+func CheckImageUserPermission(ctx context.Context, userId, imageId, permission string) (bool, error) {
+	ok, err := relation.Check(ctx, nsImage, imageId, permission, nsUser, userId)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", ErrRelation, err)
+	}
+	return ok, nil
+}
+
+// ListImageIdsByOwner 從關係庫查詢使用者擁有的所有圖片 ID。
+func ListImageIdsByOwner(ctx context.Context, userId string) ([]string, error) {
+	resp, err := relation.QueryObjectBySubjectSetRelation(ctx, nsImage, nsUser, userId, PermissionOwner)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRelation, err)
+	}
+	ids := make([]string, 0, len(resp.Objects))
+	for _, o := range resp.Objects {
+		ids = append(ids, o.Object)
+	}
+	return ids, nil
+}
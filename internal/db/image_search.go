@@ -0,0 +1,185 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const imageTextIndexName = "image_search"
+
+// imageTextIndexKeys 是圖片的全文檢索欄位，MongoDB 每個 collection 只能有一個 text index。
+var imageTextIndexKeys = bson.D{
+	{Key: "title", Value: "text"},
+	{Key: "tags", Value: "text"},
+	{Key: "alt_text", Value: "text"},
+	{Key: "description", Value: "text"},
+	{Key: "filename", Value: "text"},
+	{Key: "meta_values", Value: "text"},
+}
+
+func imageTextIndexOptions() *options.IndexOptionsBuilder {
+	return options.Index().
+		SetName(imageTextIndexName).
+		SetDefaultLanguage("none").
+		SetWeights(bson.D{
+			{Key: "title", Value: 10},
+			{Key: "tags", Value: 5},
+			{Key: "alt_text", Value: 3},
+			{Key: "description", Value: 2},
+			{Key: "filename", Value: 2},
+			{Key: "meta_values", Value: 1},
+		})
+}
+
+// metaValues 依 key 排序回傳 Meta 的值，讓相同的 Meta 產生相同的結果。
+func metaValues(meta map[string]string) []string {
+	if len(meta) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(meta))
+	for k := range meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if meta[k] != "" {
+			values = append(values, meta[k])
+		}
+	}
+	return values
+}
+
+// BackfillImageMetaValues 為加入全文檢索前建立的圖片補上 meta_values，回傳更新的圖片數量。
+func BackfillImageMetaValues(ctx context.Context) (int64, error) {
+	result, err := mgo.GetCollection(ImageCollectionName).UpdateMany(ctx,
+		bson.D{
+			{Key: "meta", Value: bson.D{{Key: "$type", Value: "object"}}},
+			{Key: "meta_values", Value: bson.D{{Key: "$exists", Value: false}}},
+		},
+		mongo.Pipeline{{{Key: "$set", Value: bson.D{
+			{Key: "meta_values", Value: bson.D{{Key: "$map", Value: bson.D{
+				{Key: "input", Value: bson.D{{Key: "$objectToArray", Value: "$meta"}}},
+				{Key: "in", Value: "$$this.v"},
+			}}}},
+		}}}},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return result.ModifiedCount, nil
+}
+
+// ImageSearch 是搜尋圖片的條件，零值的條件不會套用。
+type ImageSearch struct {
+	Query        string
+	Meta         map[string]string
+	Format       string
+	Tags         []string
+	UploadedFrom time.Time
+	UploadedTo   time.Time
+	OwnerID      string
+	ByRelevance  bool // 依全文檢索分數排序，需要 Query
+}
+
+// ImageSearchCursor 是分頁的位置：依時間排序時使用最後一張圖片的上傳時間與 ID，依分數排序時使用 Offset。
+type ImageSearchCursor struct {
+	Uploaded time.Time
+	ID       bson.ObjectID
+	Offset   int64
+}
+
+// ImageSearchHit 是一筆搜尋結果。
+type ImageSearchHit struct {
+	Image *image
+	Score float64
+}
+
+func (q ImageSearch) filter() bson.D {
+	filter := bson.D{}
+	if q.Query != "" {
+		filter = append(filter, bson.E{Key: "$text", Value: bson.D{{Key: "$search", Value: q.Query}}})
+	}
+	keys := make([]string, 0, len(q.Meta))
+	for k := range q.Meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		filter = append(filter, bson.E{Key: "meta." + k, Value: q.Meta[k]})
+	}
+	if q.Format != "" {
+		filter = append(filter, bson.E{Key: "meta.format", Value: q.Format})
+	}
+	if tags := NormalizeTags(q.Tags); len(tags) > 0 {
+		filter = append(filter, bson.E{Key: "tags", Value: bson.D{{Key: "$all", Value: tags}}})
+	}
+	uploaded := bson.D{}
+	if !q.UploadedFrom.IsZero() {
+		uploaded = append(uploaded, bson.E{Key: "$gte", Value: q.UploadedFrom})
+	}
+	if !q.UploadedTo.IsZero() {
+		uploaded = append(uploaded, bson.E{Key: "$lt", Value: q.UploadedTo})
+	}
+	if len(uploaded) > 0 {
+		filter = append(filter, bson.E{Key: "uploaded", Value: uploaded})
+	}
+	if q.OwnerID != "" {
+		filter = append(filter, bson.E{Key: "owner_id", Value: q.OwnerID})
+	}
+	return filter
+}
+
+// SearchImages 搜尋圖片，cursor 為 nil 時從第一筆開始，回傳的每一筆結果都可以轉成下一頁的 cursor。
+func SearchImages(ctx context.Context, q ImageSearch, cursor *ImageSearchCursor, limit int64) ([]ImageSearchHit, error) {
+	byRelevance := q.ByRelevance && q.Query != ""
+	filter := q.filter()
+	opts := options.Find().SetLimit(limit)
+	if byRelevance {
+		score := bson.D{{Key: "$meta", Value: "textScore"}}
+		opts.SetProjection(bson.D{{Key: "score", Value: score}}).
+			SetSort(bson.D{{Key: "score", Value: score}, {Key: "_id", Value: 1}})
+		if cursor != nil {
+			opts.SetSkip(cursor.Offset)
+		}
+	} else {
+		opts.SetSort(bson.D{{Key: "uploaded", Value: -1}, {Key: "_id", Value: -1}})
+		if cursor != nil {
+			filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+				bson.D{{Key: "uploaded", Value: bson.D{{Key: "$lt", Value: cursor.Uploaded}}}},
+				bson.D{{Key: "uploaded", Value: cursor.Uploaded}, {Key: "_id", Value: bson.D{{Key: "$lt", Value: cursor.ID}}}},
+			}})
+		}
+	}
+
+	cur, err := mgo.GetCollection(ImageCollectionName).Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+	}
+	defer func() {
+		_ = cur.Close(ctx)
+	}()
+	hits := []ImageSearchHit{}
+	for cur.Next(ctx) {
+		img := NewImage()
+		if err := cur.Decode(img); err != nil {
+			return nil, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+		}
+		hit := ImageSearchHit{Image: img}
+		if byRelevance {
+			hit.Score, _ = cur.Current.Lookup("score").DoubleOK()
+		}
+		hits = append(hits, hit)
+	}
+	if err := cur.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+	}
+	return hits, nil
+}
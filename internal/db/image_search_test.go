@@ -0,0 +1,36 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestMetaValues(t *testing.T) {
+	assert.Nil(t, metaValues(nil))
+	assert.Equal(t, []string{"JPEG", "1080"}, metaValues(map[string]string{"width": "1080", "format": "JPEG", "latitude": ""}))
+}
+
+func TestImageSearchFilter(t *testing.T) {
+	from := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	q := ImageSearch{
+		Query:        "sunset",
+		Meta:         map[string]string{"width": "1080", "height": "720"},
+		Format:       "JPEG",
+		Tags:         []string{"Beach"},
+		UploadedFrom: from,
+		OwnerID:      "u1",
+	}
+	assert.Equal(t, bson.D{
+		{Key: "$text", Value: bson.D{{Key: "$search", Value: "sunset"}}},
+		{Key: "meta.height", Value: "720"},
+		{Key: "meta.width", Value: "1080"},
+		{Key: "meta.format", Value: "JPEG"},
+		{Key: "tags", Value: bson.D{{Key: "$all", Value: []string{"beach"}}}},
+		{Key: "uploaded", Value: bson.D{{Key: "$gte", Value: from}}},
+		{Key: "owner_id", Value: "u1"},
+	}, q.filter())
+	assert.Equal(t, bson.D{}, ImageSearch{}.filter())
+}
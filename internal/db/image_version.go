@@ -0,0 +1,221 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var (
+	ErrImageChanged         = errors.New("image changed")
+	ErrImageVersionNotFound = errors.New("image version not found")
+	ErrNoPendingReplacement = errors.New("no pending image replacement")
+)
+
+// ImageVersion 是圖片某個版本的內容，更換內容或回復版本時，原本的內容會被保存到 History。
+type ImageVersion struct {
+	Version    int               `bson:"version"`
+	ProviderID string            `bson:"provider_id"`
+	Filename   string            `bson:"filename,omitempty"`
+	Size       uint64            `bson:"size,omitempty"`
+	Uploaded   time.Time         `bson:"uploaded,omitempty"`
+	Meta       map[string]string `bson:"meta,omitempty"`
+	Variants   map[string]string `bson:"variants,omitempty"`
+	ReplacedAt time.Time         `bson:"replaced_at,omitempty"`
+	// Moderation 是這個版本的內容的審核狀態與標籤，切換到此版本時會一併恢復；
+	// 加入紀錄前保存的版本沒有此欄位，切換時維持圖片目前的審核狀態。
+	Moderation *Moderation `bson:"moderation,omitempty"`
+}
+
+// ImageReplacement 是已發出上傳 URL、尚未完成的內容更換。
+type ImageReplacement struct {
+	ProviderID  string    `bson:"provider_id"`
+	RequestedBy string    `bson:"requested_by,omitempty"`
+	CreatedAt   time.Time `bson:"created_at"`
+	// Size 是發出上傳 URL 時宣告的大小，ReservationID 是為新內容增加的容量預留的配額
+	Size          uint64        `bson:"size,omitempty"`
+	ReservationID bson.ObjectID `bson:"reservation_id,omitempty"`
+}
+
+// NewImageVersion 以 Cloudflare 上傳完成的資料建立新版本，variants 為 Cloudflare 回傳的完整 URL。
+func NewImageVersion(providerId, filename string, uploaded time.Time, size uint64, meta map[string]string, variants []string) ImageVersion {
+	tmp := NewImage(WithImageVariants(variants))
+	return ImageVersion{
+		ProviderID: providerId,
+		Filename:   filename,
+		Size:       size,
+		Uploaded:   uploaded,
+		Meta:       meta,
+		Variants:   tmp.Variants,
+	}
+}
+
+// CurrentProviderID 回傳目前內容在 Cloudflare 上的 ID，尚未更換過內容的圖片與 CloudflareID 相同。
+func (i *image) CurrentProviderID() string {
+	if i.ProviderID != "" {
+		return i.ProviderID
+	}
+	return i.CloudflareID
+}
+
+// CurrentVersion 回傳目前的版本，加入版本控制前建立的圖片視為第 1 版。
+func (i *image) CurrentVersion() int {
+	if i.Version <= 0 {
+		return 1
+	}
+	return i.Version
+}
+
+// Snapshot 回傳目前內容的版本紀錄。
+func (i *image) Snapshot(replacedAt time.Time) ImageVersion {
+	return ImageVersion{
+		Version:    i.CurrentVersion(),
+		ProviderID: i.CurrentProviderID(),
+		Filename:   i.Filename,
+		Size:       i.Size,
+		Uploaded:   i.Uploaded,
+		Meta:       i.Meta,
+		Variants:   i.Variants,
+		ReplacedAt: replacedAt,
+		Moderation: &Moderation{Status: i.ModerationStatus(), Labels: i.moderationLabels()},
+	}
+}
+
+func (i *image) moderationLabels() []ModerationLabel {
+	if i.Moderation == nil {
+		return nil
+	}
+	return i.Moderation.Labels
+}
+
+// FindVersion 從歷史紀錄中找出指定版本。
+func (i *image) FindVersion(version int) (ImageVersion, bool) {
+	for _, v := range i.History {
+		if v.Version == version {
+			return v, true
+		}
+	}
+	return ImageVersion{}, false
+}
+
+// ProviderIDs 回傳圖片在 Cloudflare 上所有的 ID，包含歷史版本與尚未完成的更換，刪除圖片時需要一併刪除。
+func (i *image) ProviderIDs() []string {
+	ids := []string{i.CloudflareID, i.CurrentProviderID()}
+	for _, v := range i.History {
+		ids = append(ids, v.ProviderID)
+	}
+	if i.Replacement != nil {
+		ids = append(ids, i.Replacement.ProviderID)
+	}
+	return uniqueStrings(ids)
+}
+
+// versionFilter 以版本號做樂觀鎖，加入版本控制前建立的圖片沒有 version 欄位。
+func versionFilter(img *image) bson.D {
+	filter := bson.D{{Key: "_id", Value: img.ID}}
+	if img.Version <= 0 {
+		return append(filter, bson.E{Key: "version", Value: bson.D{{Key: "$exists", Value: false}}})
+	}
+	return append(filter, bson.E{Key: "version", Value: img.Version})
+}
+
+// SetImageReplacement 記錄尚未完成的內容更換，回傳被取代的舊更換（沒有則為 nil），呼叫端應刪除其 Cloudflare 圖片。
+func SetImageReplacement(ctx context.Context, imageId string, replacement ImageReplacement) (*ImageReplacement, error) {
+	before := NewImage()
+	err := mgo.GetCollection(ImageCollectionName).
+		FindOneAndUpdate(ctx,
+			bson.D{{Key: "cloudflare_id", Value: imageId}},
+			bson.D{{Key: "$set", Value: bson.D{{Key: "replacement", Value: replacement}}}},
+			options.FindOneAndUpdate().SetReturnDocument(options.Before)).
+		Decode(before)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrImageNotFound, imageId)
+		}
+		return nil, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return before.Replacement, nil
+}
+
+// ClearImageReplacement 清除尚未完成的更換，只有仍是 providerId 的更換會被清除，避免刪掉之後重新發出的更換。
+func ClearImageReplacement(ctx context.Context, imageId, providerId string) error {
+	_, err := mgo.UpdateOne(ctx, NewImage(),
+		bson.D{{Key: "cloudflare_id", Value: imageId}, {Key: "replacement.provider_id", Value: providerId}},
+		bson.D{{Key: "$unset", Value: bson.D{{Key: "replacement", Value: ""}}}})
+	return err
+}
+
+// ApplyImageVersion 將 v 設為圖片目前的內容並把原本的內容加入歷史紀錄，版本號加一。
+// v.Moderation 不為 nil 時同時取代圖片的審核狀態與標籤。圖片在讀取後被其他請求修改時回傳 ErrImageChanged。
+func ApplyImageVersion(ctx context.Context, img *image, v ImageVersion, clearReplacement bool) (*image, error) {
+	now := time.Now().UTC()
+	set := bson.D{
+		{Key: "provider_id", Value: v.ProviderID},
+		{Key: "filename", Value: v.Filename},
+		{Key: "size", Value: v.Size},
+		{Key: "uploaded", Value: v.Uploaded},
+		{Key: "meta", Value: v.Meta},
+		{Key: "meta_values", Value: metaValues(v.Meta)},
+		{Key: "variants", Value: v.Variants},
+		{Key: "version", Value: img.CurrentVersion() + 1},
+	}
+	// 只取代審核狀態與標籤，下架的依據與下架前的狀態等紀錄不會被新內容清除
+	if v.Moderation != nil {
+		set = append(set,
+			bson.E{Key: "moderation.status", Value: v.Moderation.Status},
+			bson.E{Key: "moderation.labels", Value: v.Moderation.Labels})
+	}
+	update := bson.D{
+		{Key: "$set", Value: set},
+		{Key: "$push", Value: bson.D{{Key: "history", Value: img.Snapshot(now)}}},
+	}
+	if clearReplacement {
+		update = append(update, bson.E{Key: "$unset", Value: bson.D{{Key: "replacement", Value: ""}}})
+	}
+	updated := NewImage()
+	err := mgo.GetCollection(ImageCollectionName).
+		FindOneAndUpdate(ctx, versionFilter(img), update,
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).
+		Decode(updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrImageChanged, img.CloudflareID)
+		}
+		return nil, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return updated, nil
+}
+
+// SwitchImageVersion 在同一個交易中切換版本（見 ApplyImageVersion）、調整擁有者的用量、寫入切換版本的事件
+// （見 NewImageVersionEvent，extra 加入事件資料），並釋放更換內容時預留的配額（reservationId 為零值時略過），
+// 任一步驟失敗時版本不會被切換。
+func SwitchImageVersion(ctx context.Context, img *image, v ImageVersion, clearReplacement bool, reservationId bson.ObjectID, extra map[string]string) (*image, error) {
+	var updated *image
+	err := WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = ApplyImageVersion(ctx, img, v, clearReplacement)
+		if err != nil {
+			return err
+		}
+		if err := IncStorageUsage(ctx, StorageDeltaOf(img, -1), StorageDeltaOf(updated, 1)); err != nil {
+			return err
+		}
+		if err := SaveOutboxEvents(ctx, NewImageVersionEvent(img, updated, extra)); err != nil {
+			return err
+		}
+		if reservationId.IsZero() {
+			return nil
+		}
+		return DeleteStorageReservation(ctx, reservationId)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
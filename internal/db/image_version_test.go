@@ -0,0 +1,51 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestImageVersionHelpers(t *testing.T) {
+	img := NewImage()
+	img.CloudflareID = "cf-1"
+	assert.Equal(t, "cf-1", img.CurrentProviderID())
+	assert.Equal(t, 1, img.CurrentVersion())
+	assert.Equal(t, []string{"cf-1"}, img.ProviderIDs())
+
+	img.ProviderID = "cf-2"
+	img.Version = 2
+	img.History = []ImageVersion{{Version: 1, ProviderID: "cf-1"}}
+	img.Replacement = &ImageReplacement{ProviderID: "cf-3"}
+	assert.Equal(t, "cf-2", img.CurrentProviderID())
+	assert.ElementsMatch(t, []string{"cf-1", "cf-2", "cf-3"}, img.ProviderIDs())
+
+	v, ok := img.FindVersion(1)
+	assert.True(t, ok)
+	assert.Equal(t, "cf-1", v.ProviderID)
+	_, ok = img.FindVersion(3)
+	assert.False(t, ok)
+
+	now := time.Now()
+	snap := img.Snapshot(now)
+	assert.Equal(t, 2, snap.Version)
+	assert.Equal(t, "cf-2", snap.ProviderID)
+	assert.Equal(t, now, snap.ReplacedAt)
+	// 沒有審核紀錄的圖片視為已核准
+	assert.Equal(t, &Moderation{Status: ModerationApproved}, snap.Moderation)
+
+	labels := []ModerationLabel{{Name: "violence"}}
+	img.Moderation = &Moderation{Status: ModerationRejected, Reason: "manual", Labels: labels}
+	snap = img.Snapshot(now)
+	assert.Equal(t, &Moderation{Status: ModerationRejected, Labels: labels}, snap.Moderation)
+}
+
+func TestVersionFilter(t *testing.T) {
+	img := NewImage()
+	assert.Equal(t, bson.D{{Key: "$exists", Value: false}}, versionFilter(img)[1].Value)
+
+	img.Version = 3
+	assert.Equal(t, 3, versionFilter(img)[1].Value)
+}
@@ -0,0 +1,327 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	moderationpb "github.com/arwoosa/media/internal/pb/moderation"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	ModerationPending  = "pending"
+	ModerationHeld     = "held" // 自動分類標記的圖片，審核前不提供
+	ModerationApproved = "approved"
+	ModerationRejected = "rejected"
+	ModerationTakedown = "takedown"
+)
+
+var (
+	ErrModerationClaimed    = errors.New("moderation claimed by another admin")
+	ErrModerationNotPending = errors.New("image is not awaiting moderation")
+	ErrModerationTakedown   = errors.New("image has been taken down")
+)
+
+// ModerationLabel 是自動分類的標籤與分數。
+type ModerationLabel struct {
+	Name  string  `bson:"name"`
+	Score float64 `bson:"score"`
+}
+
+// Moderation 是圖片的審核狀態與認領紀錄。
+type Moderation struct {
+	Status      string            `bson:"status"`
+	Reason      string            `bson:"reason,omitempty"`
+	Labels      []ModerationLabel `bson:"labels,omitempty"`
+	ClaimedBy   string            `bson:"claimed_by,omitempty"`
+	ClaimedAt   time.Time         `bson:"claimed_at,omitempty"`
+	ModeratedBy string            `bson:"moderated_by,omitempty"`
+	ModeratedAt time.Time         `bson:"moderated_at,omitempty"`
+	// Takedowns 是目前生效的下架依據，所有依據都被恢復後才會解除下架
+	Takedowns []string `bson:"takedowns,omitempty"`
+	// PreviousStatus 與 PreviousReason 是下架前的審核狀態，解除下架時恢復
+	PreviousStatus string `bson:"previous_status,omitempty"`
+	PreviousReason string `bson:"previous_reason,omitempty"`
+}
+
+// WithImageModeration 設定新圖片的審核狀態與自動分類標記的標籤。
+func WithImageModeration(status string, labels ...ModerationLabel) imageOption {
+	return func(i *image) {
+		i.Moderation = &Moderation{Status: status, Labels: labels}
+	}
+}
+
+// awaitingModeration 是等待管理員審核的狀態。
+var awaitingModeration = bson.A{ModerationPending, ModerationHeld}
+
+// ModerationStatus 回傳圖片的審核狀態，沒有審核狀態的舊圖片視為已核准。
+func (i *image) ModerationStatus() string {
+	if i.Moderation == nil || i.Moderation.Status == "" {
+		return ModerationApproved
+	}
+	return i.Moderation.Status
+}
+
+// ToModerationItem 將圖片轉成審核佇列使用的格式。
+func (i *image) ToModerationItem() *moderationpb.ModerationItem {
+	item := &moderationpb.ModerationItem{
+		ImageId:     i.CloudflareID,
+		OwnerId:     i.OwnerID,
+		Filename:    i.Filename,
+		Status:      i.ModerationStatus(),
+		ReportCount: int32(i.ReportCount),
+		Hidden:      i.Hidden,
+		Uploaded:    formatTime(i.Uploaded),
+	}
+	if m := i.Moderation; m != nil {
+		item.Reason = m.Reason
+		for _, l := range m.Labels {
+			item.Labels = append(item.Labels, &moderationpb.ModerationLabel{Name: l.Name, Score: l.Score})
+		}
+		item.ClaimedBy = m.ClaimedBy
+		item.ClaimedAt = formatTime(m.ClaimedAt)
+		item.ModeratedBy = m.ModeratedBy
+		item.ModeratedAt = formatTime(m.ModeratedAt)
+	}
+	return item
+}
+
+// formatTime 將時間轉成 RFC3339 格式，零值時為空字串。
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// claimAvailable 回傳未被其他管理員認領（或認領已過期）的條件，adminId 為空字串時只接受未被認領的項目。
+func claimAvailable(adminId string, cutoff time.Time) bson.E {
+	or := bson.A{
+		bson.D{{Key: "moderation.claimed_by", Value: bson.D{{Key: "$in", Value: bson.A{nil, ""}}}}},
+		bson.D{{Key: "moderation.claimed_at", Value: bson.D{{Key: "$lt", Value: cutoff}}}},
+	}
+	if adminId != "" {
+		or = append(or, bson.D{{Key: "moderation.claimed_by", Value: adminId}})
+	}
+	return bson.E{Key: "$or", Value: or}
+}
+
+// ListModerationQueue 列出指定審核狀態的圖片，檢舉次數多的優先，其次為上傳時間早的。
+// unclaimedOnly 為 true 時只列出未被認領或認領時間早於 claimTTL 的圖片。
+func ListModerationQueue(ctx context.Context, status string, unclaimedOnly bool, claimTTL time.Duration, offset, limit int64) ([]*image, error) {
+	filter := bson.D{{Key: "moderation.status", Value: status}}
+	if unclaimedOnly {
+		filter = append(filter, claimAvailable("", time.Now().UTC().Add(-claimTTL)))
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "report_count", Value: -1}, {Key: "uploaded", Value: 1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+	return mgo.Find(ctx, NewImage(), filter, opts)
+}
+
+// moderationFailure 在條件更新沒有符合的圖片時判斷原因。
+func moderationFailure(ctx context.Context, imageId string, requirePending bool) error {
+	img, err := FindImage(ctx, imageId)
+	if err != nil {
+		return err
+	}
+	switch status := img.ModerationStatus(); {
+	case status == ModerationTakedown:
+		return fmt.Errorf("%w: %s", ErrModerationTakedown, imageId)
+	case requirePending && status != ModerationPending && status != ModerationHeld:
+		return fmt.Errorf("%w: %s", ErrModerationNotPending, imageId)
+	}
+	return fmt.Errorf("%w: %s", ErrModerationClaimed, imageId)
+}
+
+// ClaimModeration 由管理員認領待審核或被暫緩的圖片，其他管理員的認領在 claimTTL 後過期。
+func ClaimModeration(ctx context.Context, imageId, adminId string, claimTTL time.Duration) (*image, error) {
+	now := time.Now().UTC()
+	filter := bson.D{
+		{Key: "cloudflare_id", Value: imageId},
+		{Key: "moderation.status", Value: bson.D{{Key: "$in", Value: awaitingModeration}}},
+		claimAvailable(adminId, now.Add(-claimTTL)),
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "moderation.claimed_by", Value: adminId},
+		{Key: "moderation.claimed_at", Value: now},
+	}}}
+	updated := NewImage()
+	err := mgo.GetCollection(ImageCollectionName).
+		FindOneAndUpdate(ctx, filter, update,
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).
+		Decode(updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, moderationFailure(ctx, imageId, true)
+		}
+		return nil, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return updated, nil
+}
+
+// DecideModeration 記錄管理員的審核決定並釋放認領；其他管理員認領中或已下架的圖片無法變更。
+// 核准時一併解除因檢舉造成的隱藏並重新計算檢舉次數。
+func DecideModeration(ctx context.Context, imageId, adminId, status, reason string, claimTTL time.Duration) (*image, error) {
+	now := time.Now().UTC()
+	filter := bson.D{
+		{Key: "cloudflare_id", Value: imageId},
+		{Key: "moderation.status", Value: bson.D{{Key: "$ne", Value: ModerationTakedown}}},
+		claimAvailable(adminId, now.Add(-claimTTL)),
+	}
+	set := bson.D{
+		{Key: "moderation.status", Value: status},
+		{Key: "moderation.reason", Value: reason},
+		{Key: "moderation.moderated_by", Value: adminId},
+		{Key: "moderation.moderated_at", Value: now},
+	}
+	if status == ModerationApproved {
+		set = append(set, bson.E{Key: "hidden", Value: false}, bson.E{Key: "report_count", Value: 0})
+	}
+	update := bson.D{
+		{Key: "$set", Value: set},
+		{Key: "$unset", Value: bson.D{
+			{Key: "moderation.claimed_by", Value: ""},
+			{Key: "moderation.claimed_at", Value: ""},
+		}},
+	}
+	updated := NewImage()
+	err := mgo.GetCollection(ImageCollectionName).
+		FindOneAndUpdate(ctx, filter, update,
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).
+		Decode(updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, moderationFailure(ctx, imageId, false)
+		}
+		return nil, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return updated, nil
+}
+
+// TakeDownImages 將圖片改為下架狀態，reason 記錄下架的依據；回傳更新的數量。
+// 第一次下架時保存原本的審核狀態，已下架的圖片只加入新的依據，不會覆蓋其他下架的依據。
+func TakeDownImages(ctx context.Context, imageIds []string, reason, adminId string) (int64, error) {
+	return updateImages(ctx,
+		bson.D{{Key: "cloudflare_id", Value: bson.D{{Key: "$in", Value: imageIds}}}},
+		takeDownPipeline(reason, adminId, time.Now().UTC()))
+}
+
+// RestoreImages 移除圖片的下架依據 reason，其他原因下架的圖片不受影響；回傳更新的數量。
+// 沒有其他生效的依據時恢復下架前的審核狀態，否則維持下架。
+func RestoreImages(ctx context.Context, imageIds []string, reason, adminId string) (int64, error) {
+	return updateImages(ctx,
+		bson.D{
+			{Key: "cloudflare_id", Value: bson.D{{Key: "$in", Value: imageIds}}},
+			{Key: "moderation.status", Value: ModerationTakedown},
+			takedownReasonMatch(reason),
+		},
+		restorePipeline(reason, adminId, time.Now().UTC()))
+}
+
+// activeTakedowns 回傳目前生效的下架依據；記錄下架依據前下架的圖片以 moderation.reason 作為唯一的依據。
+var activeTakedowns = bson.D{{Key: "$ifNull", Value: bson.A{
+	"$moderation.takedowns",
+	bson.D{{Key: "$cond", Value: bson.A{
+		bson.D{{Key: "$eq", Value: bson.A{"$moderation.status", ModerationTakedown}}},
+		bson.A{"$moderation.reason"},
+		bson.A{},
+	}}},
+}}}
+
+// takedownReasonMatch 回傳下架依據包含 reason 的條件。
+func takedownReasonMatch(reason string) bson.E {
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.D{{Key: "moderation.takedowns", Value: reason}},
+		bson.D{
+			{Key: "moderation.takedowns", Value: bson.D{{Key: "$exists", Value: false}}},
+			{Key: "moderation.reason", Value: reason},
+		},
+	}}
+}
+
+// withoutTakedown 回傳移除 reason 之後的下架依據。
+func withoutTakedown(reason string) bson.D {
+	return bson.D{{Key: "$filter", Value: bson.D{
+		{Key: "input", Value: activeTakedowns},
+		{Key: "cond", Value: bson.D{{Key: "$ne", Value: bson.A{"$$this", reason}}}},
+	}}}
+}
+
+// takeDownPipeline 回傳下架圖片的更新：尚未下架時保存原本的審核狀態（沒有審核狀態的舊圖片視為已核准），
+// 並將 reason 加到下架依據的最後。
+func takeDownPipeline(reason, adminId string, now time.Time) mongo.Pipeline {
+	takenDown := bson.D{{Key: "$eq", Value: bson.A{"$moderation.status", ModerationTakedown}}}
+	return mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "moderation.previous_status", Value: bson.D{{Key: "$cond", Value: bson.A{
+				takenDown,
+				"$moderation.previous_status",
+				bson.D{{Key: "$ifNull", Value: bson.A{"$moderation.status", ModerationApproved}}},
+			}}}},
+			{Key: "moderation.previous_reason", Value: bson.D{{Key: "$cond", Value: bson.A{
+				takenDown,
+				"$moderation.previous_reason",
+				bson.D{{Key: "$ifNull", Value: bson.A{"$moderation.reason", ""}}},
+			}}}},
+			{Key: "moderation.takedowns", Value: bson.D{{Key: "$concatArrays", Value: bson.A{
+				withoutTakedown(reason),
+				bson.A{reason},
+			}}}},
+		}}},
+		{{Key: "$set", Value: bson.D{
+			{Key: "moderation.status", Value: ModerationTakedown},
+			{Key: "moderation.reason", Value: reason},
+			{Key: "moderation.moderated_by", Value: adminId},
+			{Key: "moderation.moderated_at", Value: now},
+		}}},
+		{{Key: "$unset", Value: bson.A{"moderation.claimed_by", "moderation.claimed_at"}}},
+	}
+}
+
+// restorePipeline 回傳移除下架依據 reason 的更新：仍有其他依據時維持下架，審核原因改為最後加入的依據；
+// 否則恢復下架前的審核狀態並清除保存的紀錄。
+func restorePipeline(reason, adminId string, now time.Time) mongo.Pipeline {
+	remaining := bson.D{{Key: "$gt", Value: bson.A{bson.D{{Key: "$size", Value: "$moderation.takedowns"}}, 0}}}
+	keepIfRemaining := func(field string) bson.D {
+		return bson.D{{Key: "$cond", Value: bson.A{remaining, field, "$$REMOVE"}}}
+	}
+	return mongo.Pipeline{
+		{{Key: "$set", Value: bson.D{
+			{Key: "moderation.takedowns", Value: withoutTakedown(reason)},
+		}}},
+		{{Key: "$set", Value: bson.D{
+			{Key: "moderation.status", Value: bson.D{{Key: "$cond", Value: bson.A{
+				remaining,
+				ModerationTakedown,
+				bson.D{{Key: "$ifNull", Value: bson.A{"$moderation.previous_status", ModerationApproved}}},
+			}}}},
+			{Key: "moderation.reason", Value: bson.D{{Key: "$cond", Value: bson.A{
+				remaining,
+				bson.D{{Key: "$arrayElemAt", Value: bson.A{"$moderation.takedowns", -1}}},
+				bson.D{{Key: "$ifNull", Value: bson.A{"$moderation.previous_reason", ""}}},
+			}}}},
+			{Key: "moderation.moderated_by", Value: adminId},
+			{Key: "moderation.moderated_at", Value: now},
+		}}},
+		{{Key: "$set", Value: bson.D{
+			{Key: "moderation.previous_status", Value: keepIfRemaining("$moderation.previous_status")},
+			{Key: "moderation.previous_reason", Value: keepIfRemaining("$moderation.previous_reason")},
+			{Key: "moderation.takedowns", Value: keepIfRemaining("$moderation.takedowns")},
+		}}},
+	}
+}
+
+func updateImages(ctx context.Context, filter bson.D, update any) (int64, error) {
+	result, err := mgo.GetCollection(ImageCollectionName).UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return result.ModifiedCount, nil
+}
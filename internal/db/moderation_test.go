@@ -0,0 +1,51 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestModerationStatus(t *testing.T) {
+	assert.Equal(t, ModerationApproved, NewImage().ModerationStatus())
+	assert.Equal(t, ModerationPending, NewImage(WithImageModeration(ModerationPending)).ModerationStatus())
+}
+
+func TestToModerationItem(t *testing.T) {
+	img := NewImage(WithImageCloudflareID("img-1"), WithImageModeration(ModerationPending))
+	item := img.ToModerationItem()
+	assert.Equal(t, "img-1", item.GetImageId())
+	assert.Equal(t, ModerationPending, item.GetStatus())
+	assert.Empty(t, item.GetClaimedAt())
+
+	img.Moderation.ClaimedBy = "admin-1"
+	img.Moderation.ClaimedAt = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	item = img.ToModerationItem()
+	assert.Equal(t, "admin-1", item.GetClaimedBy())
+	assert.Equal(t, "2024-01-02T03:04:05Z", item.GetClaimedAt())
+}
+
+func TestTakedownReasonMatch(t *testing.T) {
+	e := takedownReasonMatch("takedown:1")
+	assert.Equal(t, "$or", e.Key)
+	or := e.Value.(bson.A)
+	assert.Equal(t, bson.D{{Key: "moderation.takedowns", Value: "takedown:1"}}, or[0])
+	// 記錄下架依據前下架的圖片以 moderation.reason 比對
+	assert.Equal(t, "takedown:1", or[1].(bson.D)[1].Value)
+}
+
+func TestTakedownPipelines(t *testing.T) {
+	now := time.Now().UTC()
+	down := takeDownPipeline("takedown:1", "admin-1", now)
+	assert.Len(t, down, 3)
+	set := down[1][0].Value.(bson.D)
+	assert.Equal(t, bson.E{Key: "moderation.status", Value: ModerationTakedown}, set[0])
+	assert.Equal(t, bson.E{Key: "moderation.reason", Value: "takedown:1"}, set[1])
+
+	restore := restorePipeline("takedown:1", "admin-1", now)
+	assert.Len(t, restore, 3)
+	assert.Equal(t, "moderation.takedowns", restore[0][0].Value.(bson.D)[0].Key)
+	assert.Equal(t, withoutTakedown("takedown:1"), restore[0][0].Value.(bson.D)[0].Value)
+}
@@ -0,0 +1,295 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(outboxCollection)
+}
+
+const OutboxCollectionName = "outbox_events"
+
+// 圖片的領域事件，事件名稱同時作為發佈的 topic。
+const (
+	EventImageUploaded  = "image.uploaded"
+	EventImageDeleted   = "image.deleted"
+	EventImageModerated = "image.moderated"
+	EventImageReplaced  = "image.replaced"
+)
+
+const (
+	OutboxPending   = "pending"
+	OutboxPublished = "published"
+
+	defaultOutboxRetention = 7 * 24 * time.Hour
+)
+
+// OutboxRetention 回傳已發佈事件的保存期限，可由 outbox.retention 設定；設為 0 時永久保存。
+func OutboxRetention() time.Duration {
+	if viper.IsSet("outbox.retention") {
+		return viper.GetDuration("outbox.retention")
+	}
+	return defaultOutboxRetention
+}
+
+var outboxCollection = mgo.NewCollectDef(OutboxCollectionName, func() []mongo.IndexModel {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "next_attempt_at", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "aggregate_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "aggregate_id", Value: 1}, {Key: "status", Value: 1}, {Key: "_id", Value: 1}},
+		},
+	}
+	// 只有已發佈的事件有 published_at，未發佈的事件不會被 TTL 刪除
+	if retention := OutboxRetention(); retention > 0 {
+		indexes = append(indexes, mongo.IndexModel{
+			Keys:    bson.D{{Key: "published_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(retention / time.Second)),
+		})
+	}
+	return indexes
+})
+
+type outboxEventOption func(*OutboxEvent)
+
+func WithEventType(eventType string) outboxEventOption {
+	return func(e *OutboxEvent) {
+		e.Type = eventType
+	}
+}
+
+func WithEventAggregate(aggregateId string) outboxEventOption {
+	return func(e *OutboxEvent) {
+		e.AggregateID = aggregateId
+	}
+}
+
+func WithEventData(data map[string]string) outboxEventOption {
+	return func(e *OutboxEvent) {
+		e.Data = data
+	}
+}
+
+// OutboxEvent 是等待發佈的領域事件，與資料變更寫在同一個交易中，由 relay 發佈到 broker。
+// 事件至少發佈一次，訂閱者需要以 ID 去除重複。
+type OutboxEvent struct {
+	mgo.Index     `bson:"-"`
+	ID            bson.ObjectID     `bson:"_id,omitempty" validate:"required"`
+	Type          string            `bson:"type" validate:"required"`
+	AggregateID   string            `bson:"aggregate_id" validate:"required"`
+	Data          map[string]string `bson:"data,omitempty"`
+	Status        string            `bson:"status" validate:"required,oneof=pending published"`
+	Attempts      int               `bson:"attempts"`
+	NextAttemptAt time.Time         `bson:"next_attempt_at"`
+	LockedUntil   *time.Time        `bson:"locked_until,omitempty"`
+	LastError     string            `bson:"last_error,omitempty"`
+	CreatedAt     time.Time         `bson:"created_at"`
+	PublishedAt   *time.Time        `bson:"published_at,omitempty"`
+}
+
+func (e *OutboxEvent) Validate() error {
+	return validate.Struct(e)
+}
+
+func (e *OutboxEvent) GetId() any {
+	return e.ID
+}
+
+func (e *OutboxEvent) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		e.ID = oid
+	}
+}
+
+func NewOutboxEvent(opts ...outboxEventOption) *OutboxEvent {
+	now := time.Now().UTC()
+	e := &OutboxEvent{
+		Index:         outboxCollection,
+		ID:            bson.NewObjectID(),
+		Status:        OutboxPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// SaveOutboxEvents 保存待發佈的事件，需要與資料變更放在同一個 WithTransaction 中。
+func SaveOutboxEvents(ctx context.Context, events ...*OutboxEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	docs := make([]any, len(events))
+	for i, e := range events {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		docs[i] = e
+	}
+	_, err := mgo.GetCollection(OutboxCollectionName).InsertMany(ctx, docs)
+	if err != nil {
+		return fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return nil
+}
+
+// ClaimOutboxEvents 依建立順序認領最多 limit 筆可發佈的事件，認領期間 lease 內其他 relay 不會重複認領。
+// relay 在發佈後、標記前中斷時，事件會在 lease 到期後重新發佈。
+// 同一個 aggregate 有更早的事件尚未發佈（等待重試或由其他 relay 認領中）時，之後的事件不會被認領，
+// 因此同一張圖片的事件跨批次、跨實例都依建立順序發佈；同一批次中的事件由呼叫端依序發佈。
+func ClaimOutboxEvents(ctx context.Context, limit int, lease time.Duration) ([]*OutboxEvent, error) {
+	collection := mgo.GetCollection(OutboxCollectionName)
+	events := make([]*OutboxEvent, 0, limit)
+	claimed := make([]bson.ObjectID, 0, limit)
+	var blocked []string
+	for len(events) < limit {
+		now := time.Now().UTC()
+		filter := bson.D{
+			{Key: "status", Value: OutboxPending},
+			{Key: "next_attempt_at", Value: bson.D{{Key: "$lte", Value: now}}},
+			{Key: "$or", Value: bson.A{
+				bson.D{{Key: "locked_until", Value: bson.D{{Key: "$exists", Value: false}}}},
+				bson.D{{Key: "locked_until", Value: bson.D{{Key: "$lt", Value: now}}}},
+			}},
+		}
+		if len(blocked) > 0 {
+			filter = append(filter, bson.E{Key: "aggregate_id", Value: bson.D{{Key: "$nin", Value: blocked}}})
+		}
+		e := NewOutboxEvent()
+		err := collection.FindOneAndUpdate(ctx, filter,
+			bson.D{
+				{Key: "$set", Value: bson.D{{Key: "locked_until", Value: now.Add(lease)}}},
+				{Key: "$inc", Value: bson.D{{Key: "attempts", Value: 1}}},
+			},
+			options.FindOneAndUpdate().
+				SetSort(bson.D{{Key: "_id", Value: 1}}).
+				SetReturnDocument(options.After),
+		).Decode(e)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			break
+		}
+		if err != nil {
+			return events, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+		}
+		// 有更早的事件尚未發佈時釋放認領，並在這次認領中略過同一個 aggregate
+		n, err := collection.CountDocuments(ctx, pendingPredecessorFilter(e, claimed), options.Count().SetLimit(1))
+		if err != nil {
+			return events, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+		}
+		if n > 0 {
+			blocked = append(blocked, e.AggregateID)
+			if err := releaseOutboxClaim(ctx, e.ID); err != nil {
+				return events, err
+			}
+			continue
+		}
+		events = append(events, e)
+		claimed = append(claimed, e.ID)
+	}
+	return events, nil
+}
+
+// pendingPredecessorFilter 回傳同一個 aggregate 中比 e 更早且尚未發佈的事件，claimed 是同一批次已認領的事件。
+func pendingPredecessorFilter(e *OutboxEvent, claimed []bson.ObjectID) bson.D {
+	return bson.D{
+		{Key: "aggregate_id", Value: e.AggregateID},
+		{Key: "status", Value: OutboxPending},
+		{Key: "_id", Value: bson.D{
+			{Key: "$lt", Value: e.ID},
+			{Key: "$nin", Value: claimed},
+		}},
+	}
+}
+
+// releaseOutboxClaim 釋放沒有發佈的認領，不計入發佈次數。
+func releaseOutboxClaim(ctx context.Context, id bson.ObjectID) error {
+	_, err := mgo.UpdateOne(ctx, NewOutboxEvent(),
+		bson.D{{Key: "_id", Value: id}},
+		bson.D{
+			{Key: "$unset", Value: bson.D{{Key: "locked_until", Value: ""}}},
+			{Key: "$inc", Value: bson.D{{Key: "attempts", Value: -1}}},
+		})
+	return err
+}
+
+// MarkOutboxPublished 將事件標記為已發佈。
+func MarkOutboxPublished(ctx context.Context, id bson.ObjectID) error {
+	_, err := mgo.UpdateOne(ctx, NewOutboxEvent(),
+		bson.D{{Key: "_id", Value: id}},
+		bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: "status", Value: OutboxPublished},
+				{Key: "published_at", Value: time.Now().UTC()},
+			}},
+			{Key: "$unset", Value: bson.D{
+				{Key: "locked_until", Value: ""},
+				{Key: "last_error", Value: ""},
+			}},
+		})
+	return err
+}
+
+// MarkOutboxFailed 記錄發佈失敗的原因，並在 retryAt 之後重新發佈。
+func MarkOutboxFailed(ctx context.Context, id bson.ObjectID, cause error, retryAt time.Time) error {
+	_, err := mgo.UpdateOne(ctx, NewOutboxEvent(),
+		bson.D{{Key: "_id", Value: id}},
+		bson.D{
+			{Key: "$set", Value: bson.D{
+				{Key: "last_error", Value: cause.Error()},
+				{Key: "next_attempt_at", Value: retryAt.UTC()},
+			}},
+			{Key: "$unset", Value: bson.D{{Key: "locked_until", Value: ""}}},
+		})
+	return err
+}
+
+// NewImageEvent 建立圖片的領域事件，data 包含圖片 ID、擁有者與審核狀態，以及 extra 中的欄位。
+func NewImageEvent(eventType string, img *image, extra map[string]string) *OutboxEvent {
+	data := map[string]string{
+		"image_id":          img.CloudflareID,
+		"owner_id":          img.OwnerID,
+		"moderation_status": img.ModerationStatus(),
+	}
+	if img.Moderation != nil && img.Moderation.Reason != "" {
+		data["moderation_reason"] = img.Moderation.Reason
+	}
+	for k, v := range extra {
+		data[k] = v
+	}
+	return NewOutboxEvent(
+		WithEventType(eventType),
+		WithEventAggregate(img.CloudflareID),
+		WithEventData(data))
+}
+
+// NewImageVersionEvent 建立圖片切換版本的事件，data 另外包含新的版本號。
+// 新內容的審核狀態與原本不同時為 image.moderated，讓訂閱者依審核狀態隱藏或恢復圖片，否則為 image.replaced。
+func NewImageVersionEvent(previous, updated *image, extra map[string]string) *OutboxEvent {
+	eventType := EventImageReplaced
+	if previous.ModerationStatus() != updated.ModerationStatus() {
+		eventType = EventImageModerated
+	}
+	data := map[string]string{"version": strconv.Itoa(updated.CurrentVersion())}
+	for k, v := range extra {
+		data[k] = v
+	}
+	return NewImageEvent(eventType, updated, data)
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestNewImageEvent(t *testing.T) {
+	img := NewImage(
+		WithImageCloudflareID("img-1"),
+		WithImageOwner("u1"),
+		WithImageModeration(ModerationHeld))
+	e := NewImageEvent(EventImageUploaded, img, map[string]string{"moderated_by": "admin"})
+	assert.NoError(t, e.Validate())
+	assert.Equal(t, OutboxPending, e.Status)
+	assert.Equal(t, "img-1", e.AggregateID)
+	assert.Equal(t, map[string]string{
+		"image_id":          "img-1",
+		"owner_id":          "u1",
+		"moderation_status": ModerationHeld,
+		"moderated_by":      "admin",
+	}, e.Data)
+
+	// extra 可以覆寫預設欄位
+	e = NewImageEvent(EventImageModerated, NewImage(WithImageCloudflareID("img-2")), map[string]string{"moderation_status": ModerationPending})
+	assert.Equal(t, ModerationPending, e.Data["moderation_status"])
+}
+
+func TestNewImageVersionEvent(t *testing.T) {
+	previous := NewImage(WithImageCloudflareID("img-1"), WithImageOwner("u1"))
+	updated := NewImage(WithImageCloudflareID("img-1"), WithImageOwner("u1"))
+	updated.Version = 2
+	e := NewImageVersionEvent(previous, updated, map[string]string{"replaced_by": "u1"})
+	assert.Equal(t, EventImageReplaced, e.Type)
+	assert.Equal(t, "2", e.Data["version"])
+	assert.Equal(t, "u1", e.Data["replaced_by"])
+
+	// 審核狀態改變時為審核事件
+	updated.Moderation = &Moderation{Status: ModerationPending}
+	e = NewImageVersionEvent(previous, updated, nil)
+	assert.Equal(t, EventImageModerated, e.Type)
+	assert.Equal(t, ModerationPending, e.Data["moderation_status"])
+}
+
+func TestPendingPredecessorFilter(t *testing.T) {
+	e := NewOutboxEvent(WithEventAggregate("img-1"))
+	claimed := []bson.ObjectID{bson.NewObjectID()}
+	filter := pendingPredecessorFilter(e, claimed)
+	assert.Equal(t, bson.E{Key: "aggregate_id", Value: "img-1"}, filter[0])
+	assert.Equal(t, bson.E{Key: "status", Value: OutboxPending}, filter[1])
+	assert.Equal(t, bson.D{{Key: "$lt", Value: e.ID}, {Key: "$nin", Value: claimed}}, filter[2].Value)
+}
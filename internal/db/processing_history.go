@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+func init() {
+	mgo.RegisterIndex(processingHistoryCollection)
+}
+
+const ProcessingHistoryCollectionName = "processing_history"
+
+const (
+	ProcessingPending = "pending"
+	ProcessingSuccess = "success"
+	ProcessingFailed  = "failed"
+)
+
+var processingHistoryCollection = mgo.NewCollectDef(ProcessingHistoryCollectionName, func() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "image_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "operator_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	}
+})
+
+type processingHistoryOption func(*processingHistory)
+
+func WithProcessingImage(imageId string) processingHistoryOption {
+	return func(p *processingHistory) {
+		p.ImageID = imageId
+	}
+}
+
+func WithProcessingOperation(operation string, parameters map[string]any) processingHistoryOption {
+	return func(p *processingHistory) {
+		p.Operation = operation
+		p.Parameters = parameters
+	}
+}
+
+func WithProcessingOperator(operatorId string) processingHistoryOption {
+	return func(p *processingHistory) {
+		p.OperatorID = operatorId
+	}
+}
+
+// processingHistory 是一次圖片處理的紀錄，ImageID 為來源圖片，ResultImageID 為處理成功後產生的衍生圖片。
+type processingHistory struct {
+	mgo.Index     `bson:"-"`
+	ID            bson.ObjectID  `bson:"_id,omitempty" validate:"required"`
+	ImageID       string         `bson:"image_id" validate:"required"`
+	ResultImageID string         `bson:"result_image_id,omitempty"`
+	Operation     string         `bson:"operation" validate:"required"`
+	Parameters    map[string]any `bson:"parameters,omitempty"`
+	OperatorID    string         `bson:"operator_id,omitempty"`
+	Status        string         `bson:"status" validate:"required"`
+	ErrorMessage  string         `bson:"error_message,omitempty"`
+	DurationMs    int64          `bson:"duration_ms"`
+	ProcessedAt   time.Time      `bson:"processed_at,omitempty"`
+	CreatedAt     time.Time      `bson:"created_at"`
+}
+
+func (p *processingHistory) Validate() error {
+	return validate.Struct(p)
+}
+
+func (p *processingHistory) GetId() any {
+	return p.ID
+}
+
+func (p *processingHistory) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		p.ID = oid
+	}
+}
+
+func NewProcessingHistory(opts ...processingHistoryOption) *processingHistory {
+	p := &processingHistory{
+		Index:     processingHistoryCollection,
+		ID:        bson.NewObjectID(),
+		Status:    ProcessingPending,
+		CreatedAt: time.Now().UTC(),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// SaveProcessingHistory 以 pending 狀態記錄開始處理。
+func SaveProcessingHistory(ctx context.Context, p *processingHistory) error {
+	_, err := mgo.Save(ctx, p)
+	return err
+}
+
+// FinishProcessingHistory 記錄處理結果與耗時，err 為 nil 時狀態為 success，否則為 failed。
+func FinishProcessingHistory(ctx context.Context, p *processingHistory, resultImageId string, procErr error) error {
+	now := time.Now().UTC()
+	p.ProcessedAt = now
+	p.DurationMs = now.Sub(p.CreatedAt).Milliseconds()
+	p.Status = ProcessingSuccess
+	p.ResultImageID = resultImageId
+	if procErr != nil {
+		p.Status = ProcessingFailed
+		p.ErrorMessage = procErr.Error()
+	}
+	_, err := mgo.UpdateOne(ctx, p, bson.D{{Key: "_id", Value: p.ID}}, bson.D{{Key: "$set", Value: bson.D{
+		{Key: "status", Value: p.Status},
+		{Key: "result_image_id", Value: p.ResultImageID},
+		{Key: "error_message", Value: p.ErrorMessage},
+		{Key: "duration_ms", Value: p.DurationMs},
+		{Key: "processed_at", Value: p.ProcessedAt},
+	}}})
+	return err
+}
@@ -0,0 +1,282 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	reportpb "github.com/arwoosa/media/internal/pb/report"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(reportCollection)
+}
+
+const ReportCollectionName = "reports"
+
+const (
+	ReportOpen      = "open"
+	ReportResolved  = "resolved"
+	ReportDismissed = "dismissed"
+)
+
+var reportCollection = mgo.NewCollectDef(ReportCollectionName, func() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			// 同一使用者對同一張圖片只能有一筆未處理的檢舉
+			Keys: bson.D{{Key: "image_id", Value: 1}, {Key: "reporter_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.D{{Key: "status", Value: ReportOpen}}),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "image_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	}
+})
+
+type reportOption func(*report)
+
+func WithReportImage(imageId string) reportOption {
+	return func(r *report) {
+		r.ImageID = imageId
+	}
+}
+
+func WithReporter(reporterId string) reportOption {
+	return func(r *report) {
+		r.ReporterID = reporterId
+	}
+}
+
+func WithReportReason(reason reportpb.ReportReason, detail string) reportOption {
+	return func(r *report) {
+		r.Reason = reason.String()
+		r.Detail = detail
+	}
+}
+
+// report 是使用者對圖片的檢舉，Reason 存放 reportpb.ReportReason 的名稱。
+type report struct {
+	mgo.Index  `bson:"-"`
+	ID         bson.ObjectID `bson:"_id,omitempty" validate:"required"`
+	ImageID    string        `bson:"image_id" validate:"required"`
+	ReporterID string        `bson:"reporter_id" validate:"required"`
+	Reason     string        `bson:"reason" validate:"required"`
+	Detail     string        `bson:"detail,omitempty"`
+	Status     string        `bson:"status" validate:"required"`
+	CreatedAt  time.Time     `bson:"created_at"`
+	UpdatedAt  time.Time     `bson:"updated_at"`
+}
+
+func (r *report) Validate() error {
+	return validate.Struct(r)
+}
+
+func (r *report) GetId() any {
+	return r.ID
+}
+
+func (r *report) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		r.ID = oid
+	}
+}
+
+// ToProto 將檢舉轉成 gRPC 響應使用的格式。
+func (r *report) ToProto() *reportpb.Report {
+	return &reportpb.Report{
+		ReportId:   r.ID.Hex(),
+		ImageId:    r.ImageID,
+		ReporterId: r.ReporterID,
+		Reason:     reportpb.ReportReason(reportpb.ReportReason_value[r.Reason]),
+		Detail:     r.Detail,
+		Status:     r.Status,
+		CreatedAt:  r.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:  r.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func NewReport(opts ...reportOption) *report {
+	now := time.Now().UTC()
+	r := &report{
+		Index:     reportCollection,
+		ID:        bson.NewObjectID(),
+		Status:    ReportOpen,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// OpenReport 建立檢舉；使用者對同一張圖片已有未處理的檢舉時回傳原本的檢舉，created 為 false。
+func OpenReport(ctx context.Context, r *report) (*report, bool, error) {
+	filter := bson.D{
+		{Key: "image_id", Value: r.ImageID},
+		{Key: "reporter_id", Value: r.ReporterID},
+		{Key: "status", Value: ReportOpen},
+	}
+	result, err := mgo.GetCollection(ReportCollectionName).UpdateOne(ctx, filter,
+		bson.D{{Key: "$setOnInsert", Value: r}},
+		options.UpdateOne().SetUpsert(true))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return nil, false, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	created := err == nil && result.UpsertedCount > 0
+	if created {
+		return r, true, nil
+	}
+	existing := NewReport()
+	err = mgo.FindOne(ctx, existing, filter)
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+// ReportFilter 是列出檢舉的條件，空字串表示不限。
+type ReportFilter struct {
+	ImageID string
+	Status  string
+	Reason  string
+}
+
+func (f ReportFilter) filter() bson.D {
+	filter := bson.D{}
+	if f.ImageID != "" {
+		filter = append(filter, bson.E{Key: "image_id", Value: f.ImageID})
+	}
+	if f.Status != "" {
+		filter = append(filter, bson.E{Key: "status", Value: f.Status})
+	}
+	if f.Reason != "" {
+		filter = append(filter, bson.E{Key: "reason", Value: f.Reason})
+	}
+	return filter
+}
+
+// ListReports 依建立時間由新到舊列出檢舉。
+func ListReports(ctx context.Context, f ReportFilter, offset, limit int64) ([]*report, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+	return mgo.Find(ctx, NewReport(), f.filter(), opts)
+}
+
+// ReportStats 是檢舉的統計。
+type ReportStats struct {
+	Total        int64
+	Open         int64
+	ByReason     map[string]int64
+	ByStatus     map[string]int64
+	HiddenImages int64
+}
+
+// FindReportStats 統計檢舉數量，imageId 為空字串時統計所有圖片。
+func FindReportStats(ctx context.Context, imageId string) (*ReportStats, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: ReportFilter{ImageID: imageId}.filter()}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "status", Value: "$status"}, {Key: "reason", Value: "$reason"}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+		}}},
+	}
+	cur, err := mgo.GetCollection(ReportCollectionName).Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+	}
+	var groups []struct {
+		ID struct {
+			Status string `bson:"status"`
+			Reason string `bson:"reason"`
+		} `bson:"_id"`
+		Count int64 `bson:"count"`
+	}
+	if err := cur.All(ctx, &groups); err != nil {
+		return nil, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+	}
+	stats := &ReportStats{ByReason: map[string]int64{}, ByStatus: map[string]int64{}}
+	for _, g := range groups {
+		stats.Total += g.Count
+		stats.ByReason[g.ID.Reason] += g.Count
+		stats.ByStatus[g.ID.Status] += g.Count
+		if g.ID.Status == ReportOpen {
+			stats.Open += g.Count
+		}
+	}
+
+	hiddenFilter := bson.D{{Key: "hidden", Value: true}}
+	if imageId != "" {
+		hiddenFilter = append(hiddenFilter, bson.E{Key: "cloudflare_id", Value: imageId})
+	}
+	stats.HiddenImages, err = mgo.GetCollection(ImageCollectionName).CountDocuments(ctx, hiddenFilter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+	}
+	return stats, nil
+}
+
+// IncImageReportCount 將圖片的檢舉次數加一，回傳更新後的次數。
+func IncImageReportCount(ctx context.Context, imageId string) (int, error) {
+	updated := NewImage()
+	err := mgo.GetCollection(ImageCollectionName).
+		FindOneAndUpdate(ctx,
+			bson.D{{Key: "cloudflare_id", Value: imageId}},
+			bson.D{{Key: "$inc", Value: bson.D{{Key: "report_count", Value: 1}}}},
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).
+		Decode(updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return 0, fmt.Errorf("%w: %s", ErrImageNotFound, imageId)
+		}
+		return 0, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return updated.ReportCount, nil
+}
+
+// HideImage 隱藏圖片並放回審核佇列，圖片已隱藏、被暫緩、已駁回或已下架時回傳 false。
+func HideImage(ctx context.Context, imageId, reason string) (bool, error) {
+	modified, err := mgo.UpdateOne(ctx, NewImage(),
+		bson.D{
+			{Key: "cloudflare_id", Value: imageId},
+			{Key: "hidden", Value: bson.D{{Key: "$ne", Value: true}}},
+			{Key: "moderation.status", Value: bson.D{{Key: "$nin", Value: bson.A{ModerationHeld, ModerationRejected, ModerationTakedown}}}},
+		},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "hidden", Value: true},
+			{Key: "hidden_at", Value: time.Now().UTC()},
+			{Key: "hidden_reason", Value: reason},
+			{Key: "moderation.status", Value: ModerationPending},
+		}}})
+	if err != nil {
+		return false, err
+	}
+	return modified > 0, nil
+}
+
+// CloseReports 將圖片未處理的檢舉改為 status（resolved 或 dismissed），回傳更新的數量。
+func CloseReports(ctx context.Context, imageId, status string) (int64, error) {
+	result, err := mgo.GetCollection(ReportCollectionName).UpdateMany(ctx,
+		bson.D{{Key: "image_id", Value: imageId}, {Key: "status", Value: ReportOpen}},
+		bson.D{{Key: "$set", Value: bson.D{
+			{Key: "status", Value: status},
+			{Key: "updated_at", Value: time.Now().UTC()},
+		}}})
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return result.ModifiedCount, nil
+}
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"testing"
+
+	reportpb "github.com/arwoosa/media/internal/pb/report"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestNewReport(t *testing.T) {
+	r := NewReport(
+		WithReportImage("img-1"),
+		WithReporter("u1"),
+		WithReportReason(reportpb.ReportReason_REASON_SPAM, "ads"))
+	assert.NoError(t, r.Validate())
+	assert.Equal(t, ReportOpen, r.Status)
+	assert.Equal(t, "REASON_SPAM", r.Reason)
+
+	pb := r.ToProto()
+	assert.Equal(t, reportpb.ReportReason_REASON_SPAM, pb.GetReason())
+	assert.Equal(t, r.ID.Hex(), pb.GetReportId())
+	assert.Equal(t, "ads", pb.GetDetail())
+}
+
+func TestReportFilter(t *testing.T) {
+	assert.Equal(t, bson.D{}, ReportFilter{}.filter())
+	assert.Equal(t, bson.D{
+		{Key: "image_id", Value: "img-1"},
+		{Key: "reason", Value: "REASON_HATE"},
+	}, ReportFilter{ImageID: "img-1", Reason: "REASON_HATE"}.filter())
+}
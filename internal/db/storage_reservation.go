@@ -0,0 +1,156 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(storageReservationCollection)
+}
+
+const StorageReservationCollectionName = "storage_reservations"
+
+// 預設與上傳會話的有效期限相同，會話過期後未完成的上傳不再佔用配額。
+const defaultStorageReservationTTL = 10 * time.Minute
+
+// StorageReservationTTL 回傳上傳預留配額的有效期限，可由 quota.reservation_ttl 設定。
+func StorageReservationTTL() time.Duration {
+	if ttl := viper.GetDuration("quota.reservation_ttl"); ttl > 0 {
+		return ttl
+	}
+	return defaultStorageReservationTTL
+}
+
+var storageReservationCollection = mgo.NewCollectDef(StorageReservationCollectionName, func() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "owner_id", Value: 1}, {Key: "expires_at", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "image_ids", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+})
+
+type storageReservationOption func(*StorageReservation)
+
+func WithReservationOwner(ownerId string) storageReservationOption {
+	return func(r *StorageReservation) {
+		r.OwnerID = ownerId
+	}
+}
+
+func WithReservationSize(imageCount, totalBytes int64) storageReservationOption {
+	return func(r *StorageReservation) {
+		r.ImageCount = imageCount
+		r.TotalBytes = totalBytes
+	}
+}
+
+// StorageReservation 是已發出上傳 URL 但尚未完成的圖片所預留的配額。
+// 完成上傳或清除會話時釋放，逾期未完成的預留由 TTL 索引刪除。
+type StorageReservation struct {
+	mgo.Index  `bson:"-"`
+	ID         bson.ObjectID `bson:"_id,omitempty" validate:"required"`
+	OwnerID    string        `bson:"owner_id" validate:"required"`
+	ImageIDs   []string      `bson:"image_ids,omitempty"`
+	ImageCount int64         `bson:"image_count"`
+	TotalBytes int64         `bson:"total_bytes"`
+	CreatedAt  time.Time     `bson:"created_at"`
+	ExpiresAt  time.Time     `bson:"expires_at"`
+}
+
+func (r *StorageReservation) Validate() error {
+	return validate.Struct(r)
+}
+
+func (r *StorageReservation) GetId() any {
+	return r.ID
+}
+
+func (r *StorageReservation) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		r.ID = oid
+	}
+}
+
+func NewStorageReservation(opts ...storageReservationOption) *StorageReservation {
+	now := time.Now().UTC()
+	r := &StorageReservation{
+		Index:     storageReservationCollection,
+		ID:        bson.NewObjectID(),
+		CreatedAt: now,
+		ExpiresAt: now.Add(StorageReservationTTL()),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// SumStorageReservations 統計擁有者尚未過期的預留配額。
+func SumStorageReservations(ctx context.Context, ownerId string) (imageCount, totalBytes int64, err error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.D{
+			{Key: "owner_id", Value: ownerId},
+			{Key: "expires_at", Value: bson.D{{Key: "$gt", Value: time.Now().UTC()}}},
+		}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: nil},
+			{Key: "image_count", Value: bson.D{{Key: "$sum", Value: "$image_count"}}},
+			{Key: "total_bytes", Value: bson.D{{Key: "$sum", Value: "$total_bytes"}}},
+		}}},
+	}
+	cur, err := mgo.GetCollection(StorageReservationCollectionName).Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+	}
+	var sums []struct {
+		ImageCount int64 `bson:"image_count"`
+		TotalBytes int64 `bson:"total_bytes"`
+	}
+	if err := cur.All(ctx, &sums); err != nil {
+		return 0, 0, fmt.Errorf("%w: %w", mgo.ErrReadFailed, err)
+	}
+	if len(sums) == 0 {
+		return 0, 0, nil
+	}
+	return sums[0].ImageCount, sums[0].TotalBytes, nil
+}
+
+// SetStorageReservationImages 記錄預留配額對應的圖片，完成上傳時以圖片 ID 釋放。
+func SetStorageReservationImages(ctx context.Context, id bson.ObjectID, imageIds []string) error {
+	_, err := mgo.UpdateOne(ctx, NewStorageReservation(),
+		bson.D{{Key: "_id", Value: id}},
+		bson.D{{Key: "$set", Value: bson.D{{Key: "image_ids", Value: imageIds}}}})
+	return err
+}
+
+// DeleteStorageReservation 刪除單筆預留配額，用於發出上傳 URL 失敗時。
+func DeleteStorageReservation(ctx context.Context, id bson.ObjectID) error {
+	_, err := mgo.DeleteMany(ctx, NewStorageReservation(), bson.D{{Key: "_id", Value: id}})
+	return err
+}
+
+// ReleaseStorageReservations 釋放包含任一圖片的預留配額。
+func ReleaseStorageReservations(ctx context.Context, imageIds []string) error {
+	if len(imageIds) == 0 {
+		return nil
+	}
+	_, err := mgo.DeleteMany(ctx, NewStorageReservation(),
+		bson.D{{Key: "image_ids", Value: bson.D{{Key: "$in", Value: imageIds}}}})
+	return err
+}
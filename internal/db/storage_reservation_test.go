@@ -0,0 +1,23 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStorageReservation(t *testing.T) {
+	r := NewStorageReservation(WithReservationOwner("user-1"), WithReservationSize(2, 4096))
+	assert.Equal(t, "user-1", r.OwnerID)
+	assert.Equal(t, int64(2), r.ImageCount)
+	assert.Equal(t, int64(4096), r.TotalBytes)
+	assert.Equal(t, defaultStorageReservationTTL, r.ExpiresAt.Sub(r.CreatedAt))
+	assert.NoError(t, r.Validate())
+
+	viper.Set("quota.reservation_ttl", "30m")
+	defer viper.Set("quota.reservation_ttl", nil)
+	r = NewStorageReservation(WithReservationOwner("user-1"))
+	assert.Equal(t, 30*time.Minute, r.ExpiresAt.Sub(r.CreatedAt))
+}
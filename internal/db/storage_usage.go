@@ -0,0 +1,223 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(storageUsageCollection)
+}
+
+const StorageUsageCollectionName = "storage_usages"
+
+const unknownFormat = "UNKNOWN"
+
+var (
+	storageUsageCollection = mgo.NewCollectDef(StorageUsageCollectionName, func() []mongo.IndexModel {
+		optionsBuilder := &options.IndexOptionsBuilder{}
+		optionsBuilder.SetUnique(true)
+		return []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "owner_id", Value: 1}},
+				Options: optionsBuilder,
+			},
+		}
+	})
+)
+
+type FormatUsage struct {
+	ImageCount int64 `bson:"image_count"`
+	TotalBytes int64 `bson:"total_bytes"`
+}
+
+type storageUsage struct {
+	mgo.Index  `bson:"-"`
+	ID         bson.ObjectID          `bson:"_id,omitempty" validate:"required"`
+	OwnerID    string                 `bson:"owner_id" validate:"required"`
+	ImageCount int64                  `bson:"image_count"`
+	TotalBytes int64                  `bson:"total_bytes"`
+	ByFormat   map[string]FormatUsage `bson:"by_format,omitempty"`
+	UpdatedAt  time.Time              `bson:"updated_at"`
+}
+
+func (s *storageUsage) Validate() error {
+	return validate.Struct(s)
+}
+
+func (s *storageUsage) GetId() any {
+	return s.ID
+}
+
+func (s *storageUsage) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		s.ID = oid
+	}
+}
+
+func NewStorageUsage(ownerId string) *storageUsage {
+	return &storageUsage{
+		Index:    storageUsageCollection,
+		ID:       bson.NewObjectID(),
+		OwnerID:  ownerId,
+		ByFormat: map[string]FormatUsage{},
+	}
+}
+
+// StorageDelta 是單張圖片對擁有者用量的變化，新增為正、刪除為負。
+type StorageDelta struct {
+	OwnerID string
+	Format  string
+	Count   int64
+	Bytes   int64
+}
+
+// StorageDeltaOf 回傳圖片對擁有者用量的變化，sign 為 1 表示新增、-1 表示刪除。
+// 尚未計入用量的圖片沒有變化，避免扣除從未累加的用量；這些圖片由 BackfillStorageUsage 計入。
+func StorageDeltaOf(img *image, sign int64) StorageDelta {
+	if !img.UsageCounted {
+		return StorageDelta{}
+	}
+	return StorageDelta{
+		OwnerID: img.OwnerID,
+		Format:  img.Meta["format"],
+		Count:   sign,
+		Bytes:   sign * int64(img.Size),
+	}
+}
+
+// formatKey 將格式轉成可安全作為 MongoDB 欄位名稱的 key。
+func formatKey(format string) string {
+	format = strings.ToUpper(strings.NewReplacer(".", "_", "$", "_").Replace(format))
+	if format == "" {
+		return unknownFormat
+	}
+	return format
+}
+
+// IncStorageUsage 依擁有者累加用量，沒有擁有者的圖片會被略過。
+func IncStorageUsage(ctx context.Context, deltas ...StorageDelta) error {
+	byOwner := map[string]bson.D{}
+	owners := []string{}
+	for _, d := range deltas {
+		if d.OwnerID == "" {
+			continue
+		}
+		if _, ok := byOwner[d.OwnerID]; !ok {
+			owners = append(owners, d.OwnerID)
+		}
+		key := formatKey(d.Format)
+		byOwner[d.OwnerID] = append(byOwner[d.OwnerID],
+			bson.E{Key: "image_count", Value: d.Count},
+			bson.E{Key: "total_bytes", Value: d.Bytes},
+			bson.E{Key: "by_format." + key + ".image_count", Value: d.Count},
+			bson.E{Key: "by_format." + key + ".total_bytes", Value: d.Bytes},
+		)
+	}
+	if len(owners) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	models := make([]mongo.WriteModel, 0, len(owners))
+	for _, owner := range owners {
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.D{{Key: "owner_id", Value: owner}}).
+			SetUpdate(bson.D{
+				{Key: "$inc", Value: mergeIncFields(byOwner[owner])},
+				{Key: "$set", Value: bson.D{{Key: "updated_at", Value: now}}},
+			}).
+			SetUpsert(true))
+	}
+	_, err := mgo.GetCollection(StorageUsageCollectionName).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return nil
+}
+
+// mergeIncFields 合併同名欄位，$inc 不允許同一個欄位出現兩次。
+func mergeIncFields(fields bson.D) bson.D {
+	result := bson.D{}
+	index := map[string]int{}
+	for _, f := range fields {
+		if i, ok := index[f.Key]; ok {
+			result[i].Value = result[i].Value.(int64) + f.Value.(int64)
+			continue
+		}
+		index[f.Key] = len(result)
+		result = append(result, f)
+	}
+	return result
+}
+
+// FindStorageUsage 查詢擁有者的用量，沒有任何紀錄時回傳全為 0 的用量。
+func FindStorageUsage(ctx context.Context, ownerId string) (*storageUsage, error) {
+	usage := NewStorageUsage(ownerId)
+	err := mgo.FindOne(ctx, usage, bson.D{{Key: "owner_id", Value: ownerId}})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return NewStorageUsage(ownerId), nil
+		}
+		return nil, err
+	}
+	return usage, nil
+}
+
+const storageUsageBackfillBatch = 1000
+
+// BackfillStorageUsage 將加入用量統計前建立的圖片計入擁有者的用量，回傳補上的圖片數量。
+// 只有成功把 usage_counted 由未標記改為已標記的圖片才會累加用量，多個實例同時執行也不會重複計算。
+func BackfillStorageUsage(ctx context.Context) (int64, error) {
+	var total int64
+	for {
+		images, err := mgo.Find(ctx, NewImage(),
+			bson.D{{Key: "usage_counted", Value: bson.D{{Key: "$ne", Value: true}}}},
+			options.Find().
+				SetProjection(bson.D{{Key: "owner_id", Value: 1}, {Key: "meta", Value: 1}, {Key: "size", Value: 1}}).
+				SetLimit(storageUsageBackfillBatch))
+		if err != nil {
+			return total, err
+		}
+		if len(images) == 0 {
+			return total, nil
+		}
+		var counted int64
+		err = WithTransaction(ctx, func(ctx context.Context) error {
+			counted = 0
+			deltas := make([]StorageDelta, 0, len(images))
+			for _, img := range images {
+				result, err := mgo.GetCollection(ImageCollectionName).UpdateOne(ctx,
+					bson.D{
+						{Key: "_id", Value: img.ID},
+						{Key: "usage_counted", Value: bson.D{{Key: "$ne", Value: true}}},
+					},
+					bson.D{{Key: "$set", Value: bson.D{{Key: "usage_counted", Value: true}}}})
+				if err != nil {
+					return fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+				}
+				// 已被其他實例補上的圖片不再累加
+				if result.ModifiedCount == 0 {
+					continue
+				}
+				img.UsageCounted = true
+				deltas = append(deltas, StorageDeltaOf(img, 1))
+				counted++
+			}
+			return IncStorageUsage(ctx, deltas...)
+		})
+		if err != nil {
+			return total, err
+		}
+		total += counted
+	}
+}
@@ -0,0 +1,36 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+func TestFormatKey(t *testing.T) {
+	assert.Equal(t, "JPEG", formatKey("jpeg"))
+	assert.Equal(t, "UNKNOWN", formatKey(""))
+	assert.Equal(t, "IMAGE_SVG", formatKey("image.svg"))
+}
+
+func TestMergeIncFields(t *testing.T) {
+	merged := mergeIncFields(bson.D{
+		{Key: "image_count", Value: int64(1)},
+		{Key: "total_bytes", Value: int64(100)},
+		{Key: "image_count", Value: int64(1)},
+		{Key: "total_bytes", Value: int64(-40)},
+	})
+	assert.Equal(t, bson.D{
+		{Key: "image_count", Value: int64(2)},
+		{Key: "total_bytes", Value: int64(60)},
+	}, merged)
+}
+
+func TestStorageDeltaOf(t *testing.T) {
+	img := NewImage(WithImageOwner("user-1"), WithSize(2048), WithImageMeta(map[string]string{"format": "PNG"}), WithImageUsageCounted())
+	assert.Equal(t, StorageDelta{OwnerID: "user-1", Format: "PNG", Count: -1, Bytes: -2048}, StorageDeltaOf(img, -1))
+
+	// 尚未計入用量的圖片刪除時不能扣除用量
+	legacy := NewImage(WithImageOwner("user-1"), WithSize(2048), WithImageMeta(map[string]string{"format": "PNG"}))
+	assert.Equal(t, StorageDelta{}, StorageDeltaOf(legacy, -1))
+}
@@ -0,0 +1,254 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	takedownpb "github.com/arwoosa/media/internal/pb/takedown"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(takedownCollection)
+}
+
+const TakedownCollectionName = "takedowns"
+
+// 下架通知的狀態：received → actioned → countered → restored，或 received → dismissed。
+const (
+	TakedownReceived  = "received"
+	TakedownActioned  = "actioned"
+	TakedownDismissed = "dismissed"
+	TakedownCountered = "countered"
+	TakedownRestored  = "restored"
+)
+
+var (
+	ErrTakedownNotFound = errors.New("takedown not found")
+	ErrTakedownStatus   = errors.New("invalid takedown status")
+)
+
+var takedownCollection = mgo.NewCollectDef(TakedownCollectionName, func() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "image_ids", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	}
+})
+
+// Claimant 是提出下架通知的權利人。
+type Claimant struct {
+	Name         string `bson:"name" validate:"required"`
+	Email        string `bson:"email" validate:"required"`
+	Organization string `bson:"organization,omitempty"`
+}
+
+// CounterNotice 是圖片擁有者對下架提出的反通知。
+type CounterNotice struct {
+	OwnerID      string    `bson:"owner_id"`
+	ImageIDs     []string  `bson:"image_ids"`
+	Statement    string    `bson:"statement"`
+	ContactName  string    `bson:"contact_name"`
+	ContactEmail string    `bson:"contact_email"`
+	FiledAt      time.Time `bson:"filed_at"`
+}
+
+type takedownOption func(*takedown)
+
+func WithTakedownClaimant(claimant Claimant) takedownOption {
+	return func(t *takedown) {
+		t.Claimant = claimant
+	}
+}
+
+func WithTakedownNotice(noticeText string, imageIds []string) takedownOption {
+	return func(t *takedown) {
+		t.NoticeText = noticeText
+		t.ImageIDs = imageIds
+	}
+}
+
+func WithTakedownFiler(adminId string) takedownOption {
+	return func(t *takedown) {
+		t.FiledBy = adminId
+	}
+}
+
+// takedown 是收到的著作權下架通知（DMCA），狀態變更會記錄在稽核紀錄中。
+type takedown struct {
+	mgo.Index      `bson:"-"`
+	ID             bson.ObjectID   `bson:"_id,omitempty" validate:"required"`
+	Claimant       Claimant        `bson:"claimant"`
+	NoticeText     string          `bson:"notice_text" validate:"required"`
+	ImageIDs       []string        `bson:"image_ids" validate:"required,min=1"`
+	Status         string          `bson:"status" validate:"required"`
+	FiledBy        string          `bson:"filed_by,omitempty"`
+	ActionedBy     string          `bson:"actioned_by,omitempty"`
+	ActionedAt     time.Time       `bson:"actioned_at,omitempty"`
+	CounterNotices []CounterNotice `bson:"counter_notices,omitempty"`
+	CreatedAt      time.Time       `bson:"created_at"`
+	UpdatedAt      time.Time       `bson:"updated_at"`
+}
+
+func (t *takedown) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *takedown) GetId() any {
+	return t.ID
+}
+
+func (t *takedown) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		t.ID = oid
+	}
+}
+
+// ModerationReason 回傳因這份通知下架的圖片所記錄的審核原因。
+func (t *takedown) ModerationReason() string {
+	return "takedown:" + t.ID.Hex()
+}
+
+// ToProto 將下架通知轉成 gRPC 響應使用的格式。
+func (t *takedown) ToProto() *takedownpb.Takedown {
+	pb := &takedownpb.Takedown{
+		TakedownId: t.ID.Hex(),
+		Claimant: &takedownpb.Claimant{
+			Name:         t.Claimant.Name,
+			Email:        t.Claimant.Email,
+			Organization: t.Claimant.Organization,
+		},
+		NoticeText: t.NoticeText,
+		ImageIds:   t.ImageIDs,
+		Status:     t.Status,
+		FiledBy:    t.FiledBy,
+		ActionedBy: t.ActionedBy,
+		ActionedAt: formatTime(t.ActionedAt),
+		CreatedAt:  t.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:  t.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	for _, c := range t.CounterNotices {
+		pb.CounterNotices = append(pb.CounterNotices, &takedownpb.CounterNotice{
+			OwnerId:      c.OwnerID,
+			ImageIds:     c.ImageIDs,
+			Statement:    c.Statement,
+			ContactName:  c.ContactName,
+			ContactEmail: c.ContactEmail,
+			FiledAt:      formatTime(c.FiledAt),
+		})
+	}
+	return pb
+}
+
+func NewTakedown(opts ...takedownOption) *takedown {
+	now := time.Now().UTC()
+	t := &takedown{
+		Index:     takedownCollection,
+		ID:        bson.NewObjectID(),
+		Status:    TakedownReceived,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// FindTakedown 依 ID 查詢下架通知，不存在時回傳 ErrTakedownNotFound。
+func FindTakedown(ctx context.Context, id string) (*takedown, error) {
+	oid, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrTakedownNotFound, id)
+	}
+	t := NewTakedown()
+	err = mgo.FindOne(ctx, t, bson.D{{Key: "_id", Value: oid}})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrTakedownNotFound, id)
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// TakedownFilter 是列出下架通知的條件，空字串表示不限。
+type TakedownFilter struct {
+	Status  string
+	ImageID string
+}
+
+// ListTakedowns 依建立時間由新到舊列出下架通知。
+func ListTakedowns(ctx context.Context, f TakedownFilter, offset, limit int64) ([]*takedown, error) {
+	filter := bson.D{}
+	if f.Status != "" {
+		filter = append(filter, bson.E{Key: "status", Value: f.Status})
+	}
+	if f.ImageID != "" {
+		filter = append(filter, bson.E{Key: "image_ids", Value: f.ImageID})
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: -1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+	return mgo.Find(ctx, NewTakedown(), filter, opts)
+}
+
+// updateTakedown 只在下架通知的狀態為 from 之一時套用 update，狀態不符時回傳 ErrTakedownStatus。
+func updateTakedown(ctx context.Context, id string, from []string, update bson.D) (*takedown, error) {
+	oid, err := bson.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrTakedownNotFound, id)
+	}
+	updated := NewTakedown()
+	err = mgo.GetCollection(TakedownCollectionName).
+		FindOneAndUpdate(ctx,
+			bson.D{{Key: "_id", Value: oid}, {Key: "status", Value: bson.D{{Key: "$in", Value: from}}}},
+			update,
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).
+		Decode(updated)
+	if err == nil {
+		return updated, nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	current, err := FindTakedown(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("%w: takedown %s is %s", ErrTakedownStatus, id, current.Status)
+}
+
+// TransitionTakedown 將下架通知從 from 之一的狀態改為 to；改為 actioned 時記錄處理的管理員。
+func TransitionTakedown(ctx context.Context, id string, from []string, to, adminId string) (*takedown, error) {
+	now := time.Now().UTC()
+	set := bson.D{
+		{Key: "status", Value: to},
+		{Key: "updated_at", Value: now},
+	}
+	if to == TakedownActioned {
+		set = append(set, bson.E{Key: "actioned_by", Value: adminId}, bson.E{Key: "actioned_at", Value: now})
+	}
+	return updateTakedown(ctx, id, from, bson.D{{Key: "$set", Value: set}})
+}
+
+// AddCounterNotice 記錄擁有者的反通知，只有已下架的通知可以提出反通知。
+func AddCounterNotice(ctx context.Context, id string, notice CounterNotice) (*takedown, error) {
+	return updateTakedown(ctx, id, []string{TakedownActioned, TakedownCountered}, bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "status", Value: TakedownCountered},
+			{Key: "updated_at", Value: notice.FiledAt},
+		}},
+		{Key: "$push", Value: bson.D{{Key: "counter_notices", Value: notice}}},
+	})
+}
@@ -0,0 +1,28 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTakedownToProto(t *testing.T) {
+	td := NewTakedown(
+		WithTakedownClaimant(Claimant{Name: "Studio", Email: "legal@studio.example"}),
+		WithTakedownNotice("notice", []string{"img-1", "img-2"}),
+		WithTakedownFiler("admin-1"))
+	assert.NoError(t, td.Validate())
+	assert.Equal(t, TakedownReceived, td.Status)
+	assert.Equal(t, "takedown:"+td.ID.Hex(), td.ModerationReason())
+
+	pb := td.ToProto()
+	assert.Equal(t, "Studio", pb.GetClaimant().GetName())
+	assert.Equal(t, []string{"img-1", "img-2"}, pb.GetImageIds())
+	assert.Empty(t, pb.GetActionedAt())
+
+	td.CounterNotices = []CounterNotice{{OwnerID: "u1", ImageIDs: []string{"img-1"}, FiledAt: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)}}
+	pb = td.ToProto()
+	assert.Len(t, pb.GetCounterNotices(), 1)
+	assert.Equal(t, "2024-05-01T00:00:00Z", pb.GetCounterNotices()[0].GetFiledAt())
+}
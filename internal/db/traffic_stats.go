@@ -0,0 +1,197 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(trafficStatsCollection)
+}
+
+const TrafficStatsCollectionName = "traffic_stats"
+
+const (
+	GranularityHour = "hour"
+	GranularityDay  = "day"
+)
+
+var (
+	trafficStatsCollection = mgo.NewCollectDef(TrafficStatsCollectionName, func() []mongo.IndexModel {
+		optionsBuilder := &options.IndexOptionsBuilder{}
+		optionsBuilder.SetUnique(true)
+		return []mongo.IndexModel{
+			{
+				Keys: bson.D{
+					{Key: "image_id", Value: 1},
+					{Key: "granularity", Value: 1},
+					{Key: "date", Value: 1},
+				},
+				Options: optionsBuilder,
+			},
+		}
+	})
+)
+
+type trafficMetrics struct {
+	TotalRequests int64 `bson:"total_requests"`
+}
+
+type trafficStats struct {
+	mgo.Index   `bson:"-"`
+	ID          bson.ObjectID  `bson:"_id,omitempty" validate:"required"`
+	ImageID     string         `bson:"image_id" validate:"required"`
+	Granularity string         `bson:"granularity" validate:"required,oneof=hour day"`
+	Date        time.Time      `bson:"date" validate:"required"`
+	Metrics     trafficMetrics `bson:"metrics"`
+	CreatedAt   time.Time      `bson:"created_at"`
+	UpdatedAt   time.Time      `bson:"updated_at"`
+}
+
+func (t *trafficStats) Validate() error {
+	return validate.Struct(t)
+}
+
+func (t *trafficStats) GetId() any {
+	return t.ID
+}
+
+func (t *trafficStats) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		t.ID = oid
+	}
+}
+
+func NewTrafficStats() *trafficStats {
+	return &trafficStats{
+		Index: trafficStatsCollection,
+		ID:    bson.NewObjectID(),
+	}
+}
+
+// TrafficBucket 回傳時間 t 所屬統計區間的起始時間（UTC）。
+func TrafficBucket(t time.Time, granularity string) time.Time {
+	t = t.UTC()
+	if granularity == GranularityDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Hour)
+}
+
+func nextTrafficBucket(t time.Time, granularity string) time.Time {
+	if granularity == GranularityDay {
+		return t.AddDate(0, 0, 1)
+	}
+	return t.Add(time.Hour)
+}
+
+// TrafficView 是某張圖片在某個整點內累積的瀏覽次數。
+type TrafficView struct {
+	ImageID  string
+	ViewedAt time.Time
+	Count    int64
+}
+
+// IncTrafficStats 將瀏覽次數以 upsert $inc 累加到每小時與每日的統計區間。
+// views 的 key 由呼叫端決定，回傳兩個區間都寫入成功的 key；部分失敗時同時回傳錯誤。
+func IncTrafficStats(ctx context.Context, views map[string]TrafficView) ([]string, error) {
+	keys := make([]string, 0, len(views))
+	for k := range views {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	now := time.Now().UTC()
+	models := make([]mongo.WriteModel, 0, len(keys)*2)
+	modelKeys := make([]string, 0, len(keys)*2)
+	applied := make([]string, 0, len(keys))
+	for _, k := range keys {
+		view := views[k]
+		if view.Count <= 0 {
+			applied = append(applied, k)
+			continue
+		}
+		for _, granularity := range []string{GranularityHour, GranularityDay} {
+			models = append(models, mongo.NewUpdateOneModel().
+				SetFilter(bson.D{
+					{Key: "image_id", Value: view.ImageID},
+					{Key: "granularity", Value: granularity},
+					{Key: "date", Value: TrafficBucket(view.ViewedAt, granularity)},
+				}).
+				SetUpdate(bson.D{
+					{Key: "$inc", Value: bson.D{{Key: "metrics.total_requests", Value: view.Count}}},
+					{Key: "$set", Value: bson.D{{Key: "updated_at", Value: now}}},
+					{Key: "$setOnInsert", Value: bson.D{{Key: "created_at", Value: now}}},
+				}).
+				SetUpsert(true))
+			modelKeys = append(modelKeys, k)
+		}
+	}
+	if len(models) == 0 {
+		return applied, nil
+	}
+
+	_, err := mgo.GetCollection(TrafficStatsCollectionName).BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false))
+	if err == nil {
+		for i := 0; i < len(modelKeys); i += 2 {
+			applied = append(applied, modelKeys[i])
+		}
+		return applied, nil
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || len(bulkErr.WriteErrors) == 0 {
+		return applied, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	failed := make(map[string]struct{}, len(bulkErr.WriteErrors))
+	for _, we := range bulkErr.WriteErrors {
+		failed[modelKeys[we.Index]] = struct{}{}
+	}
+	for i := 0; i < len(modelKeys); i += 2 {
+		if _, ok := failed[modelKeys[i]]; !ok {
+			applied = append(applied, modelKeys[i])
+		}
+	}
+	return applied, fmt.Errorf("%w: %d of %d traffic stats failed: %w", mgo.ErrWriteFailed, len(failed), len(models)/2, err)
+}
+
+// FindTrafficStats 查詢圖片在 [start, end) 之間的統計區間，依時間排序。
+func FindTrafficStats(ctx context.Context, imageId, granularity string, start, end time.Time) ([]*trafficStats, error) {
+	return mgo.Find(ctx, NewTrafficStats(), bson.D{
+		{Key: "image_id", Value: imageId},
+		{Key: "granularity", Value: granularity},
+		{Key: "date", Value: bson.D{
+			{Key: "$gte", Value: TrafficBucket(start, granularity)},
+			{Key: "$lt", Value: end.UTC()},
+		}},
+	}, options.Find().SetSort(bson.D{{Key: "date", Value: 1}}))
+}
+
+// TrafficPoint 是時間序列中的一個點。
+type TrafficPoint struct {
+	Time  time.Time
+	Views int64
+}
+
+// FillTrafficSeries 將查詢到的統計區間展開成 [start, end) 的連續時間序列，沒有資料的區間補 0。
+func FillTrafficSeries(stats []*trafficStats, granularity string, start, end time.Time) []TrafficPoint {
+	views := make(map[time.Time]int64, len(stats))
+	for _, s := range stats {
+		views[s.Date.UTC()] += s.Metrics.TotalRequests
+	}
+	var result []TrafficPoint
+	end = end.UTC()
+	for t := TrafficBucket(start, granularity); t.Before(end); t = nextTrafficBucket(t, granularity) {
+		result = append(result, TrafficPoint{Time: t, Views: views[t]})
+	}
+	return result
+}
@@ -0,0 +1,35 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrafficBucket(t *testing.T) {
+	ts := time.Date(2025, 8, 20, 1, 30, 0, 0, time.FixedZone("UTC+8", 8*60*60))
+
+	assert.Equal(t, time.Date(2025, 8, 19, 17, 0, 0, 0, time.UTC), TrafficBucket(ts, GranularityHour))
+	assert.Equal(t, time.Date(2025, 8, 19, 0, 0, 0, 0, time.UTC), TrafficBucket(ts, GranularityDay))
+}
+
+func TestFillTrafficSeries(t *testing.T) {
+	start := time.Date(2025, 8, 18, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 8, 21, 0, 0, 0, 0, time.UTC)
+	stats := []*trafficStats{
+		{Granularity: GranularityDay, Date: start, Metrics: trafficMetrics{TotalRequests: 3}},
+		{Granularity: GranularityDay, Date: start.AddDate(0, 0, 2), Metrics: trafficMetrics{TotalRequests: 5}},
+	}
+
+	series := FillTrafficSeries(stats, GranularityDay, start, end)
+	assert.Equal(t, []TrafficPoint{
+		{Time: start, Views: 3},
+		{Time: start.AddDate(0, 0, 1), Views: 0},
+		{Time: start.AddDate(0, 0, 2), Views: 5},
+	}, series)
+
+	hourly := FillTrafficSeries(nil, GranularityHour, start.Add(30*time.Minute), start.Add(3*time.Hour))
+	assert.Len(t, hourly, 3)
+	assert.Equal(t, start, hourly[0].Time)
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/log"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// errCodeIllegalOperation 是單機 MongoDB 不支援交易時回傳的錯誤碼。
+const errCodeIllegalOperation = 20
+
+// transactionUnsupported 記錄目前的 MongoDB 不支援交易，之後直接依序執行。
+var transactionUnsupported atomic.Bool
+
+func isTransactionUnsupported(err error) bool {
+	var se mongo.ServerError
+	return errors.As(err, &se) && se.HasErrorCode(errCodeIllegalOperation)
+}
+
+// WithTransaction 在同一個交易中執行 fn，fn 內的資料庫操作必須使用傳入的 ctx。
+// 單機部署的 MongoDB 不支援交易，此時退回依序執行 fn，並只記錄一次警告。
+func WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if transactionUnsupported.Load() {
+		return fn(ctx)
+	}
+	sess, err := mgo.GetCollection(ImageCollectionName).Database().Client().StartSession()
+	if err != nil {
+		return fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	defer sess.EndSession(context.WithoutCancel(ctx))
+	_, err = sess.WithTransaction(ctx, func(ctx context.Context) (any, error) {
+		return nil, fn(ctx)
+	})
+	if isTransactionUnsupported(err) {
+		transactionUnsupported.Store(true)
+		log.Warn("mongodb does not support transactions, outbox events are written without one", log.Err(err))
+		return fn(ctx)
+	}
+	return err
+}
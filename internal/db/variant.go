@@ -0,0 +1,302 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/arwoosa/media/internal/cloudflare/dao"
+	variantpb "github.com/arwoosa/media/internal/pb/variant"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(variantCollection)
+}
+
+const VariantCollectionName = "variants"
+
+var (
+	ErrVariantNotFound = errors.New("variant not found")
+	ErrVariantExists   = errors.New("variant already exists")
+
+	variantCollection = mgo.NewCollectDef(VariantCollectionName, func() []mongo.IndexModel {
+		return []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "name", Value: 1}},
+				Options: options.Index().SetUnique(true),
+			},
+		}
+	})
+)
+
+type variantOption func(*variant)
+
+func WithVariantName(name string) variantOption {
+	return func(v *variant) {
+		v.Name = name
+	}
+}
+
+func WithVariantSize(width, height uint32) variantOption {
+	return func(v *variant) {
+		v.Width = width
+		v.Height = height
+	}
+}
+
+func WithVariantFit(fit string) variantOption {
+	return func(v *variant) {
+		v.Fit = fit
+	}
+}
+
+func WithVariantMetadata(metadata string) variantOption {
+	return func(v *variant) {
+		v.Metadata = metadata
+	}
+}
+
+func WithVariantNeverRequireSignedURLs(never bool) variantOption {
+	return func(v *variant) {
+		v.NeverRequireSignedURLs = never
+	}
+}
+
+// variant 是具名的變體定義，Cloudflare 以外的供應商也依相同的名稱與尺寸產生變體。
+type variant struct {
+	mgo.Index              `bson:"-"`
+	ID                     bson.ObjectID `bson:"_id,omitempty" validate:"required"`
+	Name                   string        `bson:"name" validate:"required"`
+	Width                  uint32        `bson:"width" validate:"required"`
+	Height                 uint32        `bson:"height" validate:"required"`
+	Fit                    string        `bson:"fit" validate:"required"`
+	Metadata               string        `bson:"metadata" validate:"required"`
+	NeverRequireSignedURLs bool          `bson:"never_require_signed_urls"`
+	CreatedAt              time.Time     `bson:"created_at"`
+	UpdatedAt              time.Time     `bson:"updated_at"`
+}
+
+func (v *variant) Validate() error {
+	return validate.Struct(v)
+}
+
+func (v *variant) GetId() any {
+	return v.ID
+}
+
+func (v *variant) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		v.ID = oid
+	}
+}
+
+// ToProto 將變體轉成 gRPC 響應使用的格式。
+func (v *variant) ToProto() *variantpb.Variant {
+	return &variantpb.Variant{
+		Name:                   v.Name,
+		Width:                  v.Width,
+		Height:                 v.Height,
+		Fit:                    v.Fit,
+		Metadata:               v.Metadata,
+		NeverRequireSignedUrls: v.NeverRequireSignedURLs,
+		CreatedAt:              v.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:              v.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// ToCloudflare 將變體轉成呼叫 Cloudflare 變體 API 使用的格式。
+func (v *variant) ToCloudflare() dao.Variant {
+	return dao.Variant{
+		ID:                     v.Name,
+		Width:                  v.Width,
+		Height:                 v.Height,
+		Fit:                    v.Fit,
+		Metadata:               v.Metadata,
+		NeverRequireSignedURLs: v.NeverRequireSignedURLs,
+	}
+}
+
+// VariantFromCloudflare 將 Cloudflare 上的變體轉成變體定義，用於匯入只存在於 Cloudflare 的變體。
+func VariantFromCloudflare(v dao.Variant) *variant {
+	return NewVariant(
+		WithVariantName(v.ID),
+		WithVariantSize(v.Width, v.Height),
+		WithVariantFit(v.Fit),
+		WithVariantMetadata(v.Metadata),
+		WithVariantNeverRequireSignedURLs(v.NeverRequireSignedURLs))
+}
+
+// RenderedSize 依 Cloudflare 的 fit 規則估算原圖套用此變體後的輸出尺寸，原圖尺寸未知時回傳變體的尺寸。
+func (v *variant) RenderedSize(imageWidth, imageHeight uint32) (uint32, uint32) {
+	if imageWidth == 0 || imageHeight == 0 {
+		return v.Width, v.Height
+	}
+	iw, ih := float64(imageWidth), float64(imageHeight)
+	scale := math.Min(float64(v.Width)/iw, float64(v.Height)/ih)
+	switch v.Fit {
+	case "cover", "pad":
+		return v.Width, v.Height
+	case "crop":
+		if imageWidth >= v.Width && imageHeight >= v.Height {
+			return v.Width, v.Height
+		}
+		scale = math.Min(scale, 1)
+	case "contain":
+	default:
+		// scale-down 不會放大圖片
+		scale = math.Min(scale, 1)
+	}
+	return uint32(math.Round(iw * scale)), uint32(math.Round(ih * scale))
+}
+
+func NewVariant(opts ...variantOption) *variant {
+	now := time.Now().UTC()
+	v := &variant{
+		Index:     variantCollection,
+		ID:        bson.NewObjectID(),
+		Fit:       "scale-down",
+		Metadata:  "none",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+const defaultVariantDefinitionTTL = time.Minute
+
+// variantDefinitionTTL 回傳變體定義在記憶體中的快取時間，可由 cache.variant_definition_ttl 設定。
+// 同一個實例上的變更會立即清除快取，其他實例最多延遲這段時間。
+func variantDefinitionTTL() time.Duration {
+	if d := viper.GetDuration("cache.variant_definition_ttl"); d > 0 {
+		return d
+	}
+	return defaultVariantDefinitionTTL
+}
+
+// variantCache 是所有變體定義的記憶體快取，避免每次傳遞圖片都查詢資料庫。
+var variantCache struct {
+	sync.RWMutex
+	variants []*variant
+	loadedAt time.Time
+}
+
+func invalidateVariantCache() {
+	variantCache.Lock()
+	variantCache.variants = nil
+	variantCache.Unlock()
+}
+
+// ListCachedVariants 與 ListVariants 相同，但優先使用記憶體快取，回傳的定義不可修改。
+func ListCachedVariants(ctx context.Context) ([]*variant, error) {
+	variantCache.RLock()
+	variants, loadedAt := variantCache.variants, variantCache.loadedAt
+	variantCache.RUnlock()
+	if variants != nil && time.Since(loadedAt) < variantDefinitionTTL() {
+		return variants, nil
+	}
+	variants, err := ListVariants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	variantCache.Lock()
+	variantCache.variants, variantCache.loadedAt = variants, time.Now()
+	variantCache.Unlock()
+	return variants, nil
+}
+
+// FindCachedVariant 從記憶體快取中依名稱查詢變體，不存在時回傳 ErrVariantNotFound。
+func FindCachedVariant(ctx context.Context, name string) (*variant, error) {
+	variants, err := ListCachedVariants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range variants {
+		if v.Name == name {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrVariantNotFound, name)
+}
+
+// SaveVariant 儲存新的變體定義，名稱重複時回傳 ErrVariantExists。
+func SaveVariant(ctx context.Context, v *variant) error {
+	defer invalidateVariantCache()
+	_, err := mgo.Save(ctx, v)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return fmt.Errorf("%w: %s", ErrVariantExists, v.Name)
+		}
+		return err
+	}
+	return nil
+}
+
+// FindVariant 依名稱查詢變體，不存在時回傳 ErrVariantNotFound。
+func FindVariant(ctx context.Context, name string) (*variant, error) {
+	v := NewVariant()
+	err := mgo.FindOne(ctx, v, bson.D{{Key: "name", Value: name}})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrVariantNotFound, name)
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+// ListVariants 依名稱排序列出所有變體。
+func ListVariants(ctx context.Context) ([]*variant, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	return mgo.Find(ctx, NewVariant(), bson.D{}, opts)
+}
+
+// UpdateVariant 以 v 的內容更新同名的變體並回傳更新後的內容，建立時間維持不變。
+func UpdateVariant(ctx context.Context, v *variant) (*variant, error) {
+	defer invalidateVariantCache()
+	updated := NewVariant()
+	err := mgo.GetCollection(VariantCollectionName).
+		FindOneAndUpdate(ctx,
+			bson.D{{Key: "name", Value: v.Name}},
+			bson.D{{Key: "$set", Value: bson.D{
+				{Key: "width", Value: v.Width},
+				{Key: "height", Value: v.Height},
+				{Key: "fit", Value: v.Fit},
+				{Key: "metadata", Value: v.Metadata},
+				{Key: "never_require_signed_urls", Value: v.NeverRequireSignedURLs},
+				{Key: "updated_at", Value: time.Now().UTC()},
+			}}},
+			options.FindOneAndUpdate().SetReturnDocument(options.After)).
+		Decode(updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("%w: %s", ErrVariantNotFound, v.Name)
+		}
+		return nil, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return updated, nil
+}
+
+// DeleteVariant 刪除變體定義，不存在時回傳 ErrVariantNotFound。
+func DeleteVariant(ctx context.Context, name string) error {
+	defer invalidateVariantCache()
+	deleted, err := mgo.DeleteMany(ctx, NewVariant(), bson.D{{Key: "name", Value: name}})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("%w: %s", ErrVariantNotFound, name)
+	}
+	return nil
+}
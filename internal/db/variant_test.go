@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariantRenderedSize(t *testing.T) {
+	tests := []struct {
+		name          string
+		fit           string
+		width, height uint32
+		expectedW     uint32
+		expectedH     uint32
+	}{
+		{name: "scale-down shrinks", fit: "scale-down", width: 4000, height: 3000, expectedW: 800, expectedH: 600},
+		{name: "scale-down never enlarges", fit: "scale-down", width: 400, height: 300, expectedW: 400, expectedH: 300},
+		{name: "contain enlarges", fit: "contain", width: 400, height: 300, expectedW: 800, expectedH: 600},
+		{name: "cover fills the box", fit: "cover", width: 4000, height: 1000, expectedW: 800, expectedH: 800},
+		{name: "crop smaller image", fit: "crop", width: 400, height: 300, expectedW: 400, expectedH: 300},
+		{name: "unknown image size", fit: "scale-down", width: 0, height: 0, expectedW: 800, expectedH: 800},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewVariant(WithVariantSize(800, 800), WithVariantFit(tt.fit))
+			w, h := v.RenderedSize(tt.width, tt.height)
+			assert.Equal(t, tt.expectedW, w)
+			assert.Equal(t, tt.expectedH, h)
+		})
+	}
+}
+
+func TestImageDimensions(t *testing.T) {
+	img := NewImage(WithImageMeta(map[string]string{"width": "1920", "height": "1080"}))
+	w, h := img.Dimensions()
+	assert.Equal(t, uint32(1920), w)
+	assert.Equal(t, uint32(1080), h)
+
+	w, h = NewImage().Dimensions()
+	assert.Zero(t, w)
+	assert.Zero(t, h)
+}
+
+func TestFindCachedVariant(t *testing.T) {
+	variantCache.variants = []*variant{NewVariant(WithVariantName("thumb"))}
+	variantCache.loadedAt = time.Now()
+	defer invalidateVariantCache()
+
+	v, err := FindCachedVariant(context.Background(), "thumb")
+	assert.NoError(t, err)
+	assert.Equal(t, "thumb", v.Name)
+	_, err = FindCachedVariant(context.Background(), "missing")
+	assert.ErrorIs(t, err, ErrVariantNotFound)
+
+	invalidateVariantCache()
+	assert.Nil(t, variantCache.variants)
+}
@@ -0,0 +1,271 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	watermarkpb "github.com/arwoosa/media/internal/pb/watermark"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/validate"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+func init() {
+	mgo.RegisterIndex(watermarkCollection)
+}
+
+const WatermarkCollectionName = "watermarks"
+
+const (
+	WatermarkTypeText  = "text"
+	WatermarkTypeImage = "image"
+
+	// WatermarkModeOverlay 在傳遞圖片時疊加浮水印，原圖不變。
+	WatermarkModeOverlay = "overlay"
+	// WatermarkModeBurn 在處理圖片時將浮水印寫入衍生圖片。
+	WatermarkModeBurn = "burn"
+)
+
+var (
+	ErrWatermarkNotFound = errors.New("watermark not found")
+
+	watermarkCollection = mgo.NewCollectDef(WatermarkCollectionName, func() []mongo.IndexModel {
+		return []mongo.IndexModel{
+			{
+				Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "album_id", Value: 1}},
+				Options: options.Index().SetUnique(true),
+			},
+		}
+	})
+)
+
+// AppliedWatermark 記錄已寫入圖片內容的浮水印。
+type AppliedWatermark struct {
+	WatermarkID string    `bson:"watermark_id"`
+	Type        string    `bson:"type"`
+	Mode        string    `bson:"mode"`
+	AppliedAt   time.Time `bson:"applied_at"`
+}
+
+type watermarkOption func(*watermark)
+
+func WithWatermarkOwner(ownerId string) watermarkOption {
+	return func(w *watermark) {
+		w.OwnerID = ownerId
+	}
+}
+
+// WithWatermarkAlbum 將浮水印設定套用在相簿上，空字串表示擁有者的所有圖片。
+func WithWatermarkAlbum(albumId string) watermarkOption {
+	return func(w *watermark) {
+		w.AlbumID = albumId
+	}
+}
+
+func WithWatermarkText(text string) watermarkOption {
+	return func(w *watermark) {
+		w.Type = WatermarkTypeText
+		w.Text = text
+	}
+}
+
+func WithWatermarkOverlayImage(imageId, url string) watermarkOption {
+	return func(w *watermark) {
+		w.Type = WatermarkTypeImage
+		w.OverlayImageID = imageId
+		w.OverlayURL = url
+	}
+}
+
+func WithWatermarkPlacement(position string, opacity, scale float32) watermarkOption {
+	return func(w *watermark) {
+		w.Position = position
+		w.Opacity = opacity
+		w.Scale = scale
+	}
+}
+
+func WithWatermarkMode(mode string) watermarkOption {
+	return func(w *watermark) {
+		w.Mode = mode
+	}
+}
+
+// watermark 是擁有者或相簿的浮水印設定，相簿的設定優先於擁有者的設定。
+type watermark struct {
+	mgo.Index      `bson:"-"`
+	ID             bson.ObjectID `bson:"_id,omitempty" validate:"required"`
+	OwnerID        string        `bson:"owner_id" validate:"required"`
+	AlbumID        string        `bson:"album_id"`
+	Type           string        `bson:"type" validate:"required"`
+	Text           string        `bson:"text,omitempty"`
+	OverlayImageID string        `bson:"overlay_image_id,omitempty"`
+	OverlayURL     string        `bson:"overlay_url,omitempty"`
+	Position       string        `bson:"position" validate:"required"`
+	Opacity        float32       `bson:"opacity"`
+	Scale          float32       `bson:"scale"`
+	Mode           string        `bson:"mode" validate:"required"`
+	CreatedAt      time.Time     `bson:"created_at"`
+	UpdatedAt      time.Time     `bson:"updated_at"`
+}
+
+func (w *watermark) Validate() error {
+	return validate.Struct(w)
+}
+
+func (w *watermark) GetId() any {
+	return w.ID
+}
+
+func (w *watermark) SetId(id any) {
+	if oid, ok := id.(bson.ObjectID); ok {
+		w.ID = oid
+	}
+}
+
+// ToProto 將浮水印設定轉成 gRPC 響應使用的格式。
+func (w *watermark) ToProto() *watermarkpb.Watermark {
+	return &watermarkpb.Watermark{
+		WatermarkId:    w.ID.Hex(),
+		OwnerId:        w.OwnerID,
+		AlbumId:        w.AlbumID,
+		Type:           w.Type,
+		Text:           w.Text,
+		OverlayImageId: w.OverlayImageID,
+		Position:       w.Position,
+		Opacity:        w.Opacity,
+		Scale:          w.Scale,
+		Mode:           w.Mode,
+		UpdatedAt:      w.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// Applied 回傳將此設定寫入圖片時的紀錄。
+func (w *watermark) Applied(at time.Time) *AppliedWatermark {
+	return &AppliedWatermark{
+		WatermarkID: w.ID.Hex(),
+		Type:        w.Type,
+		Mode:        w.Mode,
+		AppliedAt:   at,
+	}
+}
+
+func NewWatermark(opts ...watermarkOption) *watermark {
+	now := time.Now().UTC()
+	w := &watermark{
+		Index:     watermarkCollection,
+		ID:        bson.NewObjectID(),
+		Position:  "bottom-right",
+		Opacity:   0.5,
+		Scale:     0.2,
+		Mode:      WatermarkModeOverlay,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// UpsertWatermark 新增或取代擁有者（或相簿）的浮水印設定，回傳儲存後的內容。
+func UpsertWatermark(ctx context.Context, w *watermark) (*watermark, error) {
+	now := time.Now().UTC()
+	saved := NewWatermark()
+	err := mgo.GetCollection(WatermarkCollectionName).
+		FindOneAndUpdate(ctx,
+			bson.D{{Key: "owner_id", Value: w.OwnerID}, {Key: "album_id", Value: w.AlbumID}},
+			bson.D{
+				{Key: "$set", Value: bson.D{
+					{Key: "type", Value: w.Type},
+					{Key: "text", Value: w.Text},
+					{Key: "overlay_image_id", Value: w.OverlayImageID},
+					{Key: "overlay_url", Value: w.OverlayURL},
+					{Key: "position", Value: w.Position},
+					{Key: "opacity", Value: w.Opacity},
+					{Key: "scale", Value: w.Scale},
+					{Key: "mode", Value: w.Mode},
+					{Key: "updated_at", Value: now},
+				}},
+				{Key: "$setOnInsert", Value: bson.D{
+					{Key: "_id", Value: w.ID},
+					{Key: "created_at", Value: now},
+				}},
+			},
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)).
+		Decode(saved)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", mgo.ErrWriteFailed, err)
+	}
+	return saved, nil
+}
+
+// FindWatermark 查詢擁有者（或相簿）的浮水印設定，不存在時回傳 ErrWatermarkNotFound。
+func FindWatermark(ctx context.Context, ownerId, albumId string) (*watermark, error) {
+	w := NewWatermark()
+	err := mgo.FindOne(ctx, w, bson.D{{Key: "owner_id", Value: ownerId}, {Key: "album_id", Value: albumId}})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrWatermarkNotFound
+		}
+		return nil, err
+	}
+	return w, nil
+}
+
+// DeleteWatermark 刪除擁有者（或相簿）的浮水印設定，不存在時回傳 ErrWatermarkNotFound。
+func DeleteWatermark(ctx context.Context, ownerId, albumId string) error {
+	deleted, err := mgo.DeleteMany(ctx, NewWatermark(), bson.D{{Key: "owner_id", Value: ownerId}, {Key: "album_id", Value: albumId}})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return ErrWatermarkNotFound
+	}
+	return nil
+}
+
+// ResolveWatermark 找出圖片適用的浮水印設定：擁有者的相簿中有設定時使用最近更新的相簿設定，否則使用擁有者的設定。
+// 沒有任何設定時回傳 nil。
+func ResolveWatermark(ctx context.Context, ownerId, imageId string) (*watermark, error) {
+	if ownerId == "" {
+		return nil, nil
+	}
+	albums, err := mgo.Find(ctx, NewAlbum(),
+		bson.D{{Key: "owner_id", Value: ownerId}, {Key: "image_ids", Value: imageId}},
+		options.Find().SetProjection(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	albumIds := make([]string, 0, len(albums)+1)
+	for _, a := range albums {
+		albumIds = append(albumIds, a.ID.Hex())
+	}
+	albumIds = append(albumIds, "")
+	found, err := mgo.Find(ctx, NewWatermark(),
+		bson.D{{Key: "owner_id", Value: ownerId}, {Key: "album_id", Value: bson.D{{Key: "$in", Value: albumIds}}}})
+	if err != nil {
+		return nil, err
+	}
+	return pickWatermark(found), nil
+}
+
+// pickWatermark 優先選擇最近更新的相簿設定，沒有相簿設定時回傳擁有者的設定。
+func pickWatermark(candidates []*watermark) *watermark {
+	var result *watermark
+	for _, w := range candidates {
+		switch {
+		case result == nil:
+			result = w
+		case result.AlbumID == "" && w.AlbumID != "":
+			result = w
+		case (result.AlbumID == "") == (w.AlbumID == "") && w.UpdatedAt.After(result.UpdatedAt):
+			result = w
+		}
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickWatermark(t *testing.T) {
+	now := time.Now()
+	owner := NewWatermark(WithWatermarkOwner("u1"))
+	owner.UpdatedAt = now.Add(time.Hour)
+	older := NewWatermark(WithWatermarkOwner("u1"), WithWatermarkAlbum("a1"))
+	older.UpdatedAt = now
+	newer := NewWatermark(WithWatermarkOwner("u1"), WithWatermarkAlbum("a2"))
+	newer.UpdatedAt = now.Add(time.Minute)
+
+	assert.Nil(t, pickWatermark(nil))
+	assert.Equal(t, owner, pickWatermark([]*watermark{owner}))
+	assert.Equal(t, newer, pickWatermark([]*watermark{owner, older, newer}))
+	assert.Equal(t, newer, pickWatermark([]*watermark{newer, owner, older}))
+}
+
+func TestNewWatermarkDefaults(t *testing.T) {
+	w := NewWatermark(WithWatermarkOverlayImage("img-1", "https://imagedelivery.net/h/img-1/public"))
+	assert.Equal(t, WatermarkTypeImage, w.Type)
+	assert.Equal(t, WatermarkModeOverlay, w.Mode)
+	assert.Equal(t, "bottom-right", w.Position)
+
+	applied := w.Applied(time.Unix(0, 0))
+	assert.Equal(t, w.ID.Hex(), applied.WatermarkID)
+	assert.Equal(t, WatermarkModeOverlay, applied.Mode)
+}
@@ -0,0 +1,150 @@
+package imagehash
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+const (
+	TypeSHA256 = "sha256"
+	TypePHash  = "phash"
+)
+
+var ErrInvalidHash = errors.New("invalid hash")
+
+// SHA256 回傳內容的 SHA-256，以小寫十六進位表示。
+func SHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// PHash 計算圖片的 dHash（64 bits）：縮成 9x8 灰階後比較左右相鄰像素的亮度。
+// 支援 JPEG、PNG 與 GIF，其他格式回傳 image.ErrFormat。
+func PHash(data []byte) (uint64, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+	const w, h = 9, 8
+	b := img.Bounds()
+	if b.Dx() == 0 || b.Dy() == 0 {
+		return 0, image.ErrFormat
+	}
+	var gray [h][w]uint64
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			// 以區塊平均值縮圖，避免只取樣單一像素造成的雜訊
+			x0, x1 := b.Min.X+x*b.Dx()/w, b.Min.X+(x+1)*b.Dx()/w
+			y0, y1 := b.Min.Y+y*b.Dy()/h, b.Min.Y+(y+1)*b.Dy()/h
+			if x1 == x0 {
+				x1 = x0 + 1
+			}
+			if y1 == y0 {
+				y1 = y0 + 1
+			}
+			var sum, n uint64
+			for py := y0; py < y1; py++ {
+				for px := x0; px < x1; px++ {
+					r, g, bl, _ := img.At(px, py).RGBA()
+					sum += (299*uint64(r) + 587*uint64(g) + 114*uint64(bl)) / 1000
+					n++
+				}
+			}
+			gray[y][x] = sum / n
+		}
+	}
+	var hash uint64
+	for y := 0; y < h; y++ {
+		for x := 0; x < w-1; x++ {
+			hash <<= 1
+			if gray[y][x] > gray[y][x+1] {
+				hash |= 1
+			}
+		}
+	}
+	return hash, nil
+}
+
+// FormatPHash 將感知雜湊轉成 16 位的十六進位字串。
+func FormatPHash(hash uint64) string {
+	return fmt.Sprintf("%016x", hash)
+}
+
+// ParsePHash 解析 16 位十六進位的感知雜湊。
+func ParsePHash(s string) (uint64, error) {
+	if len(s) != 16 {
+		return 0, fmt.Errorf("%w: phash must be 16 hex characters: %s", ErrInvalidHash, s)
+	}
+	hash, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidHash, s)
+	}
+	return hash, nil
+}
+
+// Distance 回傳兩個感知雜湊的漢明距離。
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// Normalize 檢查雜湊的格式並轉成小寫。
+func Normalize(hashType, hash string) (string, error) {
+	hash = strings.ToLower(strings.TrimSpace(hash))
+	switch hashType {
+	case TypeSHA256:
+		if _, err := hex.DecodeString(hash); err != nil || len(hash) != sha256.Size*2 {
+			return "", fmt.Errorf("%w: sha256 must be 64 hex characters: %s", ErrInvalidHash, hash)
+		}
+	case TypePHash:
+		if _, err := ParsePHash(hash); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("%w: unknown hash type %q", ErrInvalidHash, hashType)
+	}
+	return hash, nil
+}
+
+// Entry 是雜湊清單中的一筆資料。
+type Entry struct {
+	Type string
+	Hash string
+}
+
+// ParseList 讀取雜湊清單：每行一個雜湊，可用 "sha256:" 或 "phash:" 前綴指定類型，
+// 沒有前綴時使用 defaultType；空白行與 # 開頭的註解會被忽略。
+func ParseList(r io.Reader, defaultType string) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		hashType, hash := defaultType, text
+		if t, h, ok := strings.Cut(text, ":"); ok {
+			hashType, hash = strings.ToLower(strings.TrimSpace(t)), h
+		}
+		normalized, err := Normalize(hashType, hash)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, Entry{Type: hashType, Hash: normalized})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
@@ -0,0 +1,66 @@
+package imagehash
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func gradientPNG(t *testing.T, w, h int, invert bool) []byte {
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			v := uint8(x * 255 / w)
+			if invert {
+				v = 255 - v
+			}
+			img.SetGray(x, y, color.Gray{Y: v})
+		}
+	}
+	buf := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(buf, img))
+	return buf.Bytes()
+}
+
+func TestPHash(t *testing.T) {
+	small, err := PHash(gradientPNG(t, 90, 80, false))
+	assert.NoError(t, err)
+	large, err := PHash(gradientPNG(t, 900, 800, false))
+	assert.NoError(t, err)
+	inverted, err := PHash(gradientPNG(t, 90, 80, true))
+	assert.NoError(t, err)
+
+	// 同一張圖縮放後距離很小，反相後差異很大
+	assert.LessOrEqual(t, Distance(small, large), 4)
+	assert.Greater(t, Distance(small, inverted), 32)
+
+	parsed, err := ParsePHash(FormatPHash(small))
+	assert.NoError(t, err)
+	assert.Equal(t, small, parsed)
+
+	_, err = PHash([]byte("not an image"))
+	assert.Error(t, err)
+}
+
+func TestParseList(t *testing.T) {
+	sha := SHA256([]byte("x"))
+	list := "# prohibited\n\n" + strings.ToUpper(sha) + "\nphash:00ff00ff00ff00ff\n"
+	entries, err := ParseList(strings.NewReader(list), TypeSHA256)
+	assert.NoError(t, err)
+	assert.Equal(t, []Entry{
+		{Type: TypeSHA256, Hash: sha},
+		{Type: TypePHash, Hash: "00ff00ff00ff00ff"},
+	}, entries)
+
+	_, err = ParseList(strings.NewReader("sha256:abc\n"), TypeSHA256)
+	assert.ErrorIs(t, err, ErrInvalidHash)
+	assert.Contains(t, err.Error(), "line 1")
+
+	_, err = ParseList(strings.NewReader("md5:abc\n"), TypeSHA256)
+	assert.ErrorIs(t, err, ErrInvalidHash)
+}
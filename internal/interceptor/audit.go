@@ -0,0 +1,218 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"path"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/vulpes/ezgrpc"
+	"github.com/arwoosa/vulpes/log"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	// HeaderRequestID 是串接同一個請求在各服務間紀錄的標頭，未帶入時由 media 服務產生。
+	HeaderRequestID = "x-request-id"
+
+	auditWriteTimeout = 3 * time.Second
+)
+
+type auditEntryKey struct{}
+
+// auditEntry 是服務實作在處理請求時補充的稽核資訊。
+type auditEntry struct {
+	mu        sync.Mutex
+	targetIDs []string
+	detail    map[string]string
+}
+
+// AuditTarget 補充這次請求影響的資源 ID，例如 BatchUpload 產生的圖片 ID。
+// 不在 Audit interceptor 之內時不做任何事。
+func AuditTarget(ctx context.Context, ids ...string) {
+	e, ok := ctx.Value(auditEntryKey{}).(*auditEntry)
+	if !ok {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.targetIDs = append(e.targetIDs, ids...)
+}
+
+// AuditDetail 補充這次請求的稽核細節，例如審核結果或理由。
+// 不在 Audit interceptor 之內時不做任何事。
+func AuditDetail(ctx context.Context, key, value string) {
+	e, ok := ctx.Value(auditEntryKey{}).(*auditEntry)
+	if !ok {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.detail == nil {
+		e.detail = map[string]string{}
+	}
+	e.detail[key] = value
+}
+
+// mutatingMethods 快取每個 RPC 是否會變更資料，避免每次請求都查詢 descriptor。
+var mutatingMethods sync.Map
+
+// isMutating 依 google.api.http 判斷 RPC 是否會變更資料：對應到 GET 的 RPC 視為唯讀，
+// 其他（包含只提供 gRPC 的排程 RPC）都需要記錄稽核紀錄。
+func isMutating(fullMethod string) bool {
+	if v, ok := mutatingMethods.Load(fullMethod); ok {
+		return v.(bool)
+	}
+	mutating := true
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	if d, err := protoregistry.GlobalFiles.FindDescriptorByName(name); err == nil {
+		if md, ok := d.(protoreflect.MethodDescriptor); ok {
+			if rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule); ok && rule.GetGet() != "" {
+				mutating = false
+			}
+		}
+	}
+	mutatingMethods.Store(fullMethod, mutating)
+	return mutating
+}
+
+// auditAction 將 "/mediaService.ImageService/BatchUpload" 拆成資源類型 "image" 與動作 "image.batch_upload"。
+func auditAction(fullMethod string) (resourceType, action string) {
+	service, method := path.Split(strings.TrimPrefix(fullMethod, "/"))
+	service = strings.TrimSuffix(service, "/")
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+	resourceType = snakeCase(strings.TrimSuffix(service, "Service"))
+	return resourceType, resourceType + "." + snakeCase(method)
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// auditTargets 取出請求中名稱為 id、*_id 或 *_ids 的字串欄位，作為稽核紀錄的資源 ID。
+func auditTargets(req any) []string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	var ids []string
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.StringKind || fd.IsMap() || !m.Has(fd) {
+			continue
+		}
+		name := string(fd.Name())
+		switch {
+		case fd.IsList() && strings.HasSuffix(name, "_ids"):
+			list := m.Get(fd).List()
+			for j := 0; j < list.Len(); j++ {
+				if id := list.Get(j).String(); id != "" {
+					ids = append(ids, id)
+				}
+			}
+		case !fd.IsList() && (name == "id" || strings.HasSuffix(name, "_id")):
+			ids = append(ids, m.Get(fd).String())
+		}
+	}
+	return ids
+}
+
+// requestID 回傳請求帶入的 x-request-id，沒有時產生新的 ID。
+func requestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(HeaderRequestID); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// auditErrorCode 優先使用錯誤中的 ErrorInfo.Reason，沒有時使用 gRPC 狀態碼。
+func auditErrorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	st := status.Convert(err)
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok && info.GetReason() != "" {
+			return info.GetReason()
+		}
+	}
+	if st.Code() == codes.OK {
+		return codes.Unknown.String()
+	}
+	return st.Code().String()
+}
+
+// Audit 將所有會變更資料的 RPC 記錄到 audit_logs，包含操作者、資源 ID、請求 ID、IP 與結果。
+// 服務實作可以透過 AuditTarget、AuditDetail 補充資訊；寫入失敗只記錄警告，不影響請求結果。
+func Audit() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !isMutating(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		reqId := requestID(ctx)
+		if reqId != "" {
+			if err := grpc.SetHeader(ctx, metadata.Pairs(HeaderRequestID, reqId)); err != nil {
+				log.Warn("failed to set request id header", log.Err(err))
+			}
+		}
+		entry := &auditEntry{targetIDs: auditTargets(req)}
+		resp, err := handler(context.WithValue(ctx, auditEntryKey{}, entry), req)
+
+		var actorId string
+		if u, uerr := ezgrpc.GetUser(ctx); uerr == nil && u != nil {
+			actorId = u.ID
+		}
+		entry.mu.Lock()
+		targets, detail := entry.targetIDs, entry.detail
+		entry.mu.Unlock()
+
+		resourceType, action := auditAction(info.FullMethod)
+		saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), auditWriteTimeout)
+		defer cancel()
+		werr := db.SaveAuditLog(saveCtx,
+			db.WithAuditAction(action),
+			db.WithAuditMethod(info.FullMethod),
+			db.WithAuditActor(actorId),
+			db.WithAuditResource(resourceType, targets...),
+			db.WithAuditRequest(reqId, clientIP(ctx)),
+			db.WithAuditOutcome(auditErrorCode(err)),
+			db.WithAuditDetail(detail))
+		if werr != nil {
+			log.Warn("failed to write audit log", log.String("method", info.FullMethod), log.String("request_id", reqId), log.Err(werr))
+		}
+		return resp, err
+	}
+}
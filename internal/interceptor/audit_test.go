@@ -0,0 +1,49 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arwoosa/media/internal/pb/album"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestIsMutating(t *testing.T) {
+	assert.True(t, isMutating(image.ImageService_BatchUpload_FullMethodName))
+	assert.True(t, isMutating(image.ImageService_SyncImageCount_FullMethodName))
+	assert.False(t, isMutating(image.ImageService_GetImageURI_FullMethodName))
+}
+
+func TestAuditAction(t *testing.T) {
+	resourceType, action := auditAction(image.ImageService_BatchUpload_FullMethodName)
+	assert.Equal(t, "image", resourceType)
+	assert.Equal(t, "image.batch_upload", action)
+}
+
+func TestAuditTargets(t *testing.T) {
+	assert.Equal(t, []string{"a1", "img-1", "img-2"}, auditTargets(&album.AlbumImagesRequest{
+		AlbumId:  "a1",
+		ImageIds: []string{"img-1", "img-2"},
+	}))
+	assert.Nil(t, auditTargets(&image.ClearRequest{}))
+}
+
+func TestAuditHooks(t *testing.T) {
+	// 不在 interceptor 之內時不做任何事
+	AuditTarget(context.Background(), "img-1")
+
+	e := &auditEntry{}
+	ctx := context.WithValue(context.Background(), auditEntryKey{}, e)
+	AuditTarget(ctx, "img-1")
+	AuditDetail(ctx, "status", "approved")
+	assert.Equal(t, []string{"img-1"}, e.targetIDs)
+	assert.Equal(t, map[string]string{"status": "approved"}, e.detail)
+}
+
+func TestRequestID(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(HeaderRequestID, "req-1"))
+	assert.Equal(t, "req-1", requestID(ctx))
+	assert.Len(t, requestID(context.Background()), 32)
+}
@@ -0,0 +1,159 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/media/internal/rdb"
+	"github.com/arwoosa/vulpes/ezgrpc"
+	"github.com/arwoosa/vulpes/log"
+	"github.com/spf13/viper"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	errorDomain = "media"
+
+	// headerRetryAfter 經由 gRPC-Gateway 轉成 HTTP 的 Retry-After 標頭。
+	headerRetryAfter = "retry-after"
+	headerForwarded  = "x-forwarded-for"
+
+	defaultRateWindow = time.Minute
+)
+
+// rateLimit 是單一 RPC 的限流設定，Requests 為 0 表示不限流。
+type rateLimit struct {
+	Requests int64
+	Window   time.Duration
+}
+
+// rateLimitFor 讀取 rate_limit.methods.<Method> 的設定，未設定時使用 rate_limit.default。
+func rateLimitFor(method string) rateLimit {
+	limit := rateLimit{
+		Requests: viper.GetInt64("rate_limit.default.requests"),
+		Window:   viper.GetDuration("rate_limit.default.window"),
+	}
+	prefix := "rate_limit.methods." + strings.ToLower(method)
+	if viper.IsSet(prefix + ".requests") {
+		limit.Requests = viper.GetInt64(prefix + ".requests")
+	}
+	if viper.IsSet(prefix + ".window") {
+		limit.Window = viper.GetDuration(prefix + ".window")
+	}
+	if limit.Window <= 0 {
+		limit.Window = defaultRateWindow
+	}
+	return limit
+}
+
+// rateLimitSubject 回傳限流的對象：已登入時使用使用者 ID，否則使用客戶端 IP。
+func rateLimitSubject(ctx context.Context) string {
+	if u, err := ezgrpc.GetUser(ctx); err == nil && u != nil && u.ID != "" {
+		return "user:" + u.ID
+	}
+	return "ip:" + clientIP(ctx)
+}
+
+// clientIP 回傳客戶端 IP，無法判斷時回傳 unknown。
+// x-forwarded-for 最左邊的位址可由客戶端任意填寫，只採用由右數來略過 trusted_proxies 個代理後的位址：
+// gRPC-Gateway 會把連線的來源位址附加在最右邊，前面每多一層可信任的代理就需要多略過一個。
+func clientIP(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ip := forwardedClientIP(md.Get(headerForwarded), viper.GetInt("trusted_proxies")); ip != "" {
+			return ip
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr := p.Addr.String()
+		if host, _, err := net.SplitHostPort(addr); err == nil {
+			addr = host
+		}
+		return addr
+	}
+	return "unknown"
+}
+
+// forwardedClientIP 從 x-forwarded-for 取出由右數第 trustedProxies+1 個位址，位址不足或不是合法 IP 時回傳空字串。
+func forwardedClientIP(values []string, trustedProxies int) string {
+	var hops []string
+	for _, v := range values {
+		for _, hop := range strings.Split(v, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+	if trustedProxies < 0 {
+		trustedProxies = 0
+	}
+	i := len(hops) - 1 - trustedProxies
+	if i < 0 {
+		return ""
+	}
+	if net.ParseIP(hops[i]) == nil {
+		return ""
+	}
+	return hops[i]
+}
+
+// retryAfterSeconds 將等待時間無條件進位成秒，至少為 1 秒。
+func retryAfterSeconds(d time.Duration) int64 {
+	secs := int64((d + time.Second - 1) / time.Second)
+	if secs < 1 {
+		return 1
+	}
+	return secs
+}
+
+// RateLimit 依 rate_limit 設定對每個使用者（或 IP）的每個 RPC 進行限流。
+// 超過限制時回傳 ResourceExhausted 與 RATE_LIMIT_EXCEEDED，並設定 Retry-After 標頭。
+// Redis 無法使用時放行請求，避免限流元件影響服務可用性。
+func RateLimit() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !viper.GetBool("rate_limit.enabled") {
+			return handler(ctx, req)
+		}
+		method := path.Base(info.FullMethod)
+		limit := rateLimitFor(method)
+		if limit.Requests <= 0 {
+			return handler(ctx, req)
+		}
+		result, err := rdb.AllowRate(ctx, method, rateLimitSubject(ctx), limit.Requests, limit.Window)
+		if err != nil {
+			log.Warn("rate limit check failed", log.String("method", method), log.Err(err))
+			return handler(ctx, req)
+		}
+		if result.Allowed {
+			return handler(ctx, req)
+		}
+
+		retryAfter := strconv.FormatInt(retryAfterSeconds(result.RetryAfter), 10)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(headerRetryAfter, retryAfter)); err != nil {
+			log.Warn("failed to set retry-after header", log.Err(err))
+		}
+		st, err := status.New(codes.ResourceExhausted, "rate limit exceeded").WithDetails(&errdetails.ErrorInfo{
+			Reason: image.ErrorCode_RATE_LIMIT_EXCEEDED.String(),
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"method":      method,
+				"limit":       strconv.FormatInt(limit.Requests, 10),
+				"window":      limit.Window.String(),
+				"retry_after": retryAfter,
+			},
+		})
+		if err != nil {
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return nil, st.Err()
+	}
+}
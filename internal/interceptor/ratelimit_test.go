@@ -0,0 +1,55 @@
+package interceptor
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestRateLimitFor(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	viper.Set("rate_limit.default.requests", 100)
+	viper.Set("rate_limit.methods.BatchUpload.requests", 20)
+	viper.Set("rate_limit.methods.GetImageURI.requests", 600)
+	viper.Set("rate_limit.methods.GetImageURI.window", "10s")
+
+	assert.Equal(t, rateLimit{Requests: 20, Window: time.Minute}, rateLimitFor("BatchUpload"))
+	assert.Equal(t, rateLimit{Requests: 600, Window: 10 * time.Second}, rateLimitFor("GetImageURI"))
+	assert.Equal(t, rateLimit{Requests: 100, Window: time.Minute}, rateLimitFor("Delete"))
+}
+
+func TestRateLimitSubject(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-id", "u1", headerForwarded, "1.2.3.4"))
+	assert.Equal(t, "user:u1", rateLimitSubject(ctx))
+
+	// 最左邊的位址由客戶端填寫，不能作為限流依據
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(headerForwarded, "1.2.3.4, 10.0.0.1"))
+	assert.Equal(t, "ip:10.0.0.1", rateLimitSubject(ctx))
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(headerForwarded, "not-an-ip"))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("5.6.7.8"), Port: 5000}})
+	assert.Equal(t, "ip:5.6.7.8", rateLimitSubject(ctx))
+
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("5.6.7.8"), Port: 5000}})
+	assert.Equal(t, "ip:5.6.7.8", rateLimitSubject(ctx))
+}
+
+func TestForwardedClientIP(t *testing.T) {
+	assert.Equal(t, "10.0.0.1", forwardedClientIP([]string{"1.2.3.4, 10.0.0.1"}, 0))
+	assert.Equal(t, "1.2.3.4", forwardedClientIP([]string{"6.6.6.6, 1.2.3.4", "10.0.0.1"}, 1))
+	assert.Equal(t, "", forwardedClientIP([]string{"10.0.0.1"}, 1))
+	assert.Equal(t, "", forwardedClientIP(nil, 0))
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	assert.Equal(t, int64(1), retryAfterSeconds(0))
+	assert.Equal(t, int64(1), retryAfterSeconds(200*time.Millisecond))
+	assert.Equal(t, int64(3), retryAfterSeconds(2001*time.Millisecond))
+}
@@ -0,0 +1,55 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// registrar 在註冊服務時替每個 unary method 加上 media 服務自己的 interceptor。
+// ezgrpc 的 gRPC 伺服器在建立時就固定了 interceptor，因此改由包裝 ServiceDesc 的方式串接，
+// 執行順序為：ezgrpc 的 interceptor → 這裡的 interceptor（依傳入順序）→ 服務實作。
+type registrar struct {
+	grpc.ServiceRegistrar
+	interceptors []grpc.UnaryServerInterceptor
+}
+
+// WrapRegistrar 回傳會套用 interceptors 的 ServiceRegistrar。
+func WrapRegistrar(s grpc.ServiceRegistrar, interceptors ...grpc.UnaryServerInterceptor) grpc.ServiceRegistrar {
+	return &registrar{
+		ServiceRegistrar: s,
+		interceptors:     interceptors,
+	}
+}
+
+func (r *registrar) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	wrapped := *desc
+	wrapped.Methods = make([]grpc.MethodDesc, len(desc.Methods))
+	for i, m := range desc.Methods {
+		handler := m.Handler
+		wrapped.Methods[i] = grpc.MethodDesc{
+			MethodName: m.MethodName,
+			Handler: func(srv any, ctx context.Context, dec func(any) error, outer grpc.UnaryServerInterceptor) (any, error) {
+				return handler(srv, ctx, dec, chain(outer, r.interceptors))
+			},
+		}
+	}
+	r.ServiceRegistrar.RegisterService(&wrapped, impl)
+}
+
+// chain 將 interceptors 串在 outer 之後，outer 為 nil 時直接執行 interceptors。
+func chain(outer grpc.UnaryServerInterceptor, interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			current, h := interceptors[i], next
+			next = func(ctx context.Context, req any) (any, error) {
+				return current(ctx, req, info, h)
+			}
+		}
+		if outer == nil {
+			return next(ctx, req)
+		}
+		return outer(ctx, req, info, next)
+	}
+}
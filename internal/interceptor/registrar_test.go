@@ -0,0 +1,40 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func recordInterceptor(name string, calls *[]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		*calls = append(*calls, name)
+		return handler(ctx, req)
+	}
+}
+
+func TestChain(t *testing.T) {
+	var calls []string
+	handler := func(ctx context.Context, req any) (any, error) {
+		calls = append(calls, "handler")
+		return req, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/image.ImageService/GetImageURI"}
+
+	ic := chain(recordInterceptor("outer", &calls), []grpc.UnaryServerInterceptor{
+		recordInterceptor("first", &calls),
+		recordInterceptor("second", &calls),
+	})
+	resp, err := ic(context.Background(), "req", info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "req", resp)
+	assert.Equal(t, []string{"outer", "first", "second", "handler"}, calls)
+
+	calls = nil
+	ic = chain(nil, []grpc.UnaryServerInterceptor{recordInterceptor("first", &calls)})
+	_, err = ic(context.Background(), "req", info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"first", "handler"}, calls)
+}
@@ -0,0 +1,1334 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/album.proto
+
+package album
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Album with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Album) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Album with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AlbumMultiError, or nil if none found.
+func (m *Album) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Album) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AlbumId
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for CoverImageId
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return AlbumMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlbumMultiError is an error wrapping multiple validation errors returned by
+// Album.ValidateAll() if the designated constraints aren't met.
+type AlbumMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlbumMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlbumMultiError) AllErrors() []error { return m }
+
+// AlbumValidationError is the validation error returned by Album.Validate if
+// the designated constraints aren't met.
+type AlbumValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlbumValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlbumValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlbumValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlbumValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlbumValidationError) ErrorName() string { return "AlbumValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlbumValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlbum.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlbumValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlbumValidationError{}
+
+// Validate checks the field values on CreateAlbumRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAlbumRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAlbumRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAlbumRequestMultiError, or nil if none found.
+func (m *CreateAlbumRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAlbumRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := CreateAlbumRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+		err := CreateAlbumRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetImageIds()) > 50 {
+		err := CreateAlbumRequestValidationError{
+			field:  "ImageIds",
+			reason: "value must contain no more than 50 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetImageIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := CreateAlbumRequestValidationError{
+				field:  fmt.Sprintf("ImageIds[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_CreateAlbumRequest_ImageIds_Pattern.MatchString(item) {
+			err := CreateAlbumRequestValidationError{
+				field:  fmt.Sprintf("ImageIds[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateAlbumRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAlbumRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateAlbumRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateAlbumRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAlbumRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAlbumRequestMultiError) AllErrors() []error { return m }
+
+// CreateAlbumRequestValidationError is the validation error returned by
+// CreateAlbumRequest.Validate if the designated constraints aren't met.
+type CreateAlbumRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAlbumRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAlbumRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAlbumRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAlbumRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAlbumRequestValidationError) ErrorName() string {
+	return "CreateAlbumRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAlbumRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAlbumRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAlbumRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAlbumRequestValidationError{}
+
+var _CreateAlbumRequest_ImageIds_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on AlbumRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AlbumRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlbumRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AlbumRequestMultiError, or
+// nil if none found.
+func (m *AlbumRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlbumRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_AlbumRequest_AlbumId_Pattern.MatchString(m.GetAlbumId()) {
+		err := AlbumRequestValidationError{
+			field:  "AlbumId",
+			reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AlbumRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlbumRequestMultiError is an error wrapping multiple validation errors
+// returned by AlbumRequest.ValidateAll() if the designated constraints aren't met.
+type AlbumRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlbumRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlbumRequestMultiError) AllErrors() []error { return m }
+
+// AlbumRequestValidationError is the validation error returned by
+// AlbumRequest.Validate if the designated constraints aren't met.
+type AlbumRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlbumRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlbumRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlbumRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlbumRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlbumRequestValidationError) ErrorName() string { return "AlbumRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AlbumRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlbumRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlbumRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlbumRequestValidationError{}
+
+var _AlbumRequest_AlbumId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+// Validate checks the field values on ListAlbumsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListAlbumsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAlbumsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAlbumsRequestMultiError, or nil if none found.
+func (m *ListAlbumsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAlbumsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListAlbumsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := ListAlbumsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAlbumsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAlbumsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAlbumsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAlbumsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAlbumsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAlbumsRequestMultiError) AllErrors() []error { return m }
+
+// ListAlbumsRequestValidationError is the validation error returned by
+// ListAlbumsRequest.Validate if the designated constraints aren't met.
+type ListAlbumsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAlbumsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAlbumsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAlbumsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAlbumsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAlbumsRequestValidationError) ErrorName() string {
+	return "ListAlbumsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAlbumsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAlbumsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAlbumsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAlbumsRequestValidationError{}
+
+// Validate checks the field values on ListAlbumsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAlbumsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAlbumsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAlbumsResponseMultiError, or nil if none found.
+func (m *ListAlbumsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAlbumsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAlbums() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAlbumsResponseValidationError{
+						field:  fmt.Sprintf("Albums[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAlbumsResponseValidationError{
+						field:  fmt.Sprintf("Albums[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAlbumsResponseValidationError{
+					field:  fmt.Sprintf("Albums[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAlbumsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAlbumsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListAlbumsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListAlbumsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAlbumsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAlbumsResponseMultiError) AllErrors() []error { return m }
+
+// ListAlbumsResponseValidationError is the validation error returned by
+// ListAlbumsResponse.Validate if the designated constraints aren't met.
+type ListAlbumsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAlbumsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAlbumsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAlbumsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAlbumsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAlbumsResponseValidationError) ErrorName() string {
+	return "ListAlbumsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAlbumsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAlbumsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAlbumsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAlbumsResponseValidationError{}
+
+// Validate checks the field values on RenameAlbumRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameAlbumRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameAlbumRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameAlbumRequestMultiError, or nil if none found.
+func (m *RenameAlbumRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameAlbumRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RenameAlbumRequest_AlbumId_Pattern.MatchString(m.GetAlbumId()) {
+		err := RenameAlbumRequestValidationError{
+			field:  "AlbumId",
+			reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := RenameAlbumRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 1000 {
+		err := RenameAlbumRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameAlbumRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameAlbumRequestMultiError is an error wrapping multiple validation errors
+// returned by RenameAlbumRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenameAlbumRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameAlbumRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameAlbumRequestMultiError) AllErrors() []error { return m }
+
+// RenameAlbumRequestValidationError is the validation error returned by
+// RenameAlbumRequest.Validate if the designated constraints aren't met.
+type RenameAlbumRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameAlbumRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameAlbumRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameAlbumRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameAlbumRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameAlbumRequestValidationError) ErrorName() string {
+	return "RenameAlbumRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameAlbumRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameAlbumRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameAlbumRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameAlbumRequestValidationError{}
+
+var _RenameAlbumRequest_AlbumId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+// Validate checks the field values on DeleteAlbumResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAlbumResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAlbumResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAlbumResponseMultiError, or nil if none found.
+func (m *DeleteAlbumResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAlbumResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteAlbumResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAlbumResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAlbumResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAlbumResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAlbumResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAlbumResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAlbumResponseValidationError is the validation error returned by
+// DeleteAlbumResponse.Validate if the designated constraints aren't met.
+type DeleteAlbumResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAlbumResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAlbumResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAlbumResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAlbumResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAlbumResponseValidationError) ErrorName() string {
+	return "DeleteAlbumResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAlbumResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAlbumResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAlbumResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAlbumResponseValidationError{}
+
+// Validate checks the field values on AlbumImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AlbumImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AlbumImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AlbumImagesRequestMultiError, or nil if none found.
+func (m *AlbumImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AlbumImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_AlbumImagesRequest_AlbumId_Pattern.MatchString(m.GetAlbumId()) {
+		err := AlbumImagesRequestValidationError{
+			field:  "AlbumId",
+			reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetImageIds()); l < 1 || l > 50 {
+		err := AlbumImagesRequestValidationError{
+			field:  "ImageIds",
+			reason: "value must contain between 1 and 50 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetImageIds() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := AlbumImagesRequestValidationError{
+				field:  fmt.Sprintf("ImageIds[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_AlbumImagesRequest_ImageIds_Pattern.MatchString(item) {
+			err := AlbumImagesRequestValidationError{
+				field:  fmt.Sprintf("ImageIds[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AlbumImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AlbumImagesRequestMultiError is an error wrapping multiple validation errors
+// returned by AlbumImagesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AlbumImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AlbumImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AlbumImagesRequestMultiError) AllErrors() []error { return m }
+
+// AlbumImagesRequestValidationError is the validation error returned by
+// AlbumImagesRequest.Validate if the designated constraints aren't met.
+type AlbumImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AlbumImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AlbumImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AlbumImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AlbumImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AlbumImagesRequestValidationError) ErrorName() string {
+	return "AlbumImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AlbumImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAlbumImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AlbumImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AlbumImagesRequestValidationError{}
+
+var _AlbumImagesRequest_AlbumId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+var _AlbumImagesRequest_ImageIds_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on ReorderAlbumImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReorderAlbumImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReorderAlbumImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReorderAlbumImagesRequestMultiError, or nil if none found.
+func (m *ReorderAlbumImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReorderAlbumImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ReorderAlbumImagesRequest_AlbumId_Pattern.MatchString(m.GetAlbumId()) {
+		err := ReorderAlbumImagesRequestValidationError{
+			field:  "AlbumId",
+			reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReorderAlbumImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReorderAlbumImagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ReorderAlbumImagesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ReorderAlbumImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReorderAlbumImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReorderAlbumImagesRequestMultiError) AllErrors() []error { return m }
+
+// ReorderAlbumImagesRequestValidationError is the validation error returned by
+// ReorderAlbumImagesRequest.Validate if the designated constraints aren't met.
+type ReorderAlbumImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReorderAlbumImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReorderAlbumImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReorderAlbumImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReorderAlbumImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReorderAlbumImagesRequestValidationError) ErrorName() string {
+	return "ReorderAlbumImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReorderAlbumImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReorderAlbumImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReorderAlbumImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReorderAlbumImagesRequestValidationError{}
+
+var _ReorderAlbumImagesRequest_AlbumId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+// Validate checks the field values on SetAlbumCoverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetAlbumCoverRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetAlbumCoverRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetAlbumCoverRequestMultiError, or nil if none found.
+func (m *SetAlbumCoverRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetAlbumCoverRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_SetAlbumCoverRequest_AlbumId_Pattern.MatchString(m.GetAlbumId()) {
+		err := SetAlbumCoverRequestValidationError{
+			field:  "AlbumId",
+			reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := SetAlbumCoverRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SetAlbumCoverRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := SetAlbumCoverRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetAlbumCoverRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetAlbumCoverRequestMultiError is an error wrapping multiple validation
+// errors returned by SetAlbumCoverRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetAlbumCoverRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetAlbumCoverRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetAlbumCoverRequestMultiError) AllErrors() []error { return m }
+
+// SetAlbumCoverRequestValidationError is the validation error returned by
+// SetAlbumCoverRequest.Validate if the designated constraints aren't met.
+type SetAlbumCoverRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAlbumCoverRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAlbumCoverRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAlbumCoverRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAlbumCoverRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAlbumCoverRequestValidationError) ErrorName() string {
+	return "SetAlbumCoverRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAlbumCoverRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAlbumCoverRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAlbumCoverRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAlbumCoverRequestValidationError{}
+
+var _SetAlbumCoverRequest_AlbumId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+var _SetAlbumCoverRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
@@ -0,0 +1,423 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/album.proto
+
+package album
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AlbumService_CreateAlbum_FullMethodName        = "/mediaService.AlbumService/CreateAlbum"
+	AlbumService_GetAlbum_FullMethodName           = "/mediaService.AlbumService/GetAlbum"
+	AlbumService_ListAlbums_FullMethodName         = "/mediaService.AlbumService/ListAlbums"
+	AlbumService_RenameAlbum_FullMethodName        = "/mediaService.AlbumService/RenameAlbum"
+	AlbumService_DeleteAlbum_FullMethodName        = "/mediaService.AlbumService/DeleteAlbum"
+	AlbumService_AddAlbumImages_FullMethodName     = "/mediaService.AlbumService/AddAlbumImages"
+	AlbumService_RemoveAlbumImages_FullMethodName  = "/mediaService.AlbumService/RemoveAlbumImages"
+	AlbumService_ReorderAlbumImages_FullMethodName = "/mediaService.AlbumService/ReorderAlbumImages"
+	AlbumService_SetAlbumCover_FullMethodName      = "/mediaService.AlbumService/SetAlbumCover"
+)
+
+// AlbumServiceClient is the client API for AlbumService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AlbumServiceClient interface {
+	// 建立相簿
+	CreateAlbum(ctx context.Context, in *CreateAlbumRequest, opts ...grpc.CallOption) (*Album, error)
+	// 取得相簿
+	GetAlbum(ctx context.Context, in *AlbumRequest, opts ...grpc.CallOption) (*Album, error)
+	// 列出目前使用者的相簿
+	ListAlbums(ctx context.Context, in *ListAlbumsRequest, opts ...grpc.CallOption) (*ListAlbumsResponse, error)
+	// 重新命名相簿
+	RenameAlbum(ctx context.Context, in *RenameAlbumRequest, opts ...grpc.CallOption) (*Album, error)
+	// 刪除相簿（不會刪除相簿中的圖片）
+	DeleteAlbum(ctx context.Context, in *AlbumRequest, opts ...grpc.CallOption) (*DeleteAlbumResponse, error)
+	// 新增圖片到相簿
+	AddAlbumImages(ctx context.Context, in *AlbumImagesRequest, opts ...grpc.CallOption) (*Album, error)
+	// 從相簿移除圖片
+	RemoveAlbumImages(ctx context.Context, in *AlbumImagesRequest, opts ...grpc.CallOption) (*Album, error)
+	// 重新排序相簿圖片
+	ReorderAlbumImages(ctx context.Context, in *ReorderAlbumImagesRequest, opts ...grpc.CallOption) (*Album, error)
+	// 設定相簿封面
+	SetAlbumCover(ctx context.Context, in *SetAlbumCoverRequest, opts ...grpc.CallOption) (*Album, error)
+}
+
+type albumServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlbumServiceClient(cc grpc.ClientConnInterface) AlbumServiceClient {
+	return &albumServiceClient{cc}
+}
+
+func (c *albumServiceClient) CreateAlbum(ctx context.Context, in *CreateAlbumRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, AlbumService_CreateAlbum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) GetAlbum(ctx context.Context, in *AlbumRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, AlbumService_GetAlbum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) ListAlbums(ctx context.Context, in *ListAlbumsRequest, opts ...grpc.CallOption) (*ListAlbumsResponse, error) {
+	out := new(ListAlbumsResponse)
+	err := c.cc.Invoke(ctx, AlbumService_ListAlbums_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) RenameAlbum(ctx context.Context, in *RenameAlbumRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, AlbumService_RenameAlbum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) DeleteAlbum(ctx context.Context, in *AlbumRequest, opts ...grpc.CallOption) (*DeleteAlbumResponse, error) {
+	out := new(DeleteAlbumResponse)
+	err := c.cc.Invoke(ctx, AlbumService_DeleteAlbum_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) AddAlbumImages(ctx context.Context, in *AlbumImagesRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, AlbumService_AddAlbumImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) RemoveAlbumImages(ctx context.Context, in *AlbumImagesRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, AlbumService_RemoveAlbumImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) ReorderAlbumImages(ctx context.Context, in *ReorderAlbumImagesRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, AlbumService_ReorderAlbumImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) SetAlbumCover(ctx context.Context, in *SetAlbumCoverRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, AlbumService_SetAlbumCover_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AlbumServiceServer is the server API for AlbumService service.
+// All implementations must embed UnimplementedAlbumServiceServer
+// for forward compatibility
+type AlbumServiceServer interface {
+	// 建立相簿
+	CreateAlbum(context.Context, *CreateAlbumRequest) (*Album, error)
+	// 取得相簿
+	GetAlbum(context.Context, *AlbumRequest) (*Album, error)
+	// 列出目前使用者的相簿
+	ListAlbums(context.Context, *ListAlbumsRequest) (*ListAlbumsResponse, error)
+	// 重新命名相簿
+	RenameAlbum(context.Context, *RenameAlbumRequest) (*Album, error)
+	// 刪除相簿（不會刪除相簿中的圖片）
+	DeleteAlbum(context.Context, *AlbumRequest) (*DeleteAlbumResponse, error)
+	// 新增圖片到相簿
+	AddAlbumImages(context.Context, *AlbumImagesRequest) (*Album, error)
+	// 從相簿移除圖片
+	RemoveAlbumImages(context.Context, *AlbumImagesRequest) (*Album, error)
+	// 重新排序相簿圖片
+	ReorderAlbumImages(context.Context, *ReorderAlbumImagesRequest) (*Album, error)
+	// 設定相簿封面
+	SetAlbumCover(context.Context, *SetAlbumCoverRequest) (*Album, error)
+	mustEmbedUnimplementedAlbumServiceServer()
+}
+
+// UnimplementedAlbumServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAlbumServiceServer struct {
+}
+
+func (UnimplementedAlbumServiceServer) CreateAlbum(context.Context, *CreateAlbumRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlbum not implemented")
+}
+func (UnimplementedAlbumServiceServer) GetAlbum(context.Context, *AlbumRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlbum not implemented")
+}
+func (UnimplementedAlbumServiceServer) ListAlbums(context.Context, *ListAlbumsRequest) (*ListAlbumsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlbums not implemented")
+}
+func (UnimplementedAlbumServiceServer) RenameAlbum(context.Context, *RenameAlbumRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameAlbum not implemented")
+}
+func (UnimplementedAlbumServiceServer) DeleteAlbum(context.Context, *AlbumRequest) (*DeleteAlbumResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlbum not implemented")
+}
+func (UnimplementedAlbumServiceServer) AddAlbumImages(context.Context, *AlbumImagesRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAlbumImages not implemented")
+}
+func (UnimplementedAlbumServiceServer) RemoveAlbumImages(context.Context, *AlbumImagesRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAlbumImages not implemented")
+}
+func (UnimplementedAlbumServiceServer) ReorderAlbumImages(context.Context, *ReorderAlbumImagesRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderAlbumImages not implemented")
+}
+func (UnimplementedAlbumServiceServer) SetAlbumCover(context.Context, *SetAlbumCoverRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAlbumCover not implemented")
+}
+func (UnimplementedAlbumServiceServer) mustEmbedUnimplementedAlbumServiceServer() {}
+
+// UnsafeAlbumServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlbumServiceServer will
+// result in compilation errors.
+type UnsafeAlbumServiceServer interface {
+	mustEmbedUnimplementedAlbumServiceServer()
+}
+
+func RegisterAlbumServiceServer(s grpc.ServiceRegistrar, srv AlbumServiceServer) {
+	s.RegisterService(&AlbumService_ServiceDesc, srv)
+}
+
+func _AlbumService_CreateAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).CreateAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_CreateAlbum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).CreateAlbum(ctx, req.(*CreateAlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_GetAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).GetAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_GetAlbum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).GetAlbum(ctx, req.(*AlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_ListAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlbumsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).ListAlbums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_ListAlbums_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).ListAlbums(ctx, req.(*ListAlbumsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_RenameAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameAlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).RenameAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_RenameAlbum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).RenameAlbum(ctx, req.(*RenameAlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_DeleteAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).DeleteAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_DeleteAlbum_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).DeleteAlbum(ctx, req.(*AlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_AddAlbumImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlbumImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).AddAlbumImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_AddAlbumImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).AddAlbumImages(ctx, req.(*AlbumImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_RemoveAlbumImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlbumImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).RemoveAlbumImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_RemoveAlbumImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).RemoveAlbumImages(ctx, req.(*AlbumImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_ReorderAlbumImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderAlbumImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).ReorderAlbumImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_ReorderAlbumImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).ReorderAlbumImages(ctx, req.(*ReorderAlbumImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_SetAlbumCover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAlbumCoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).SetAlbumCover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlbumService_SetAlbumCover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).SetAlbumCover(ctx, req.(*SetAlbumCoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AlbumService_ServiceDesc is the grpc.ServiceDesc for AlbumService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AlbumService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mediaService.AlbumService",
+	HandlerType: (*AlbumServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAlbum",
+			Handler:    _AlbumService_CreateAlbum_Handler,
+		},
+		{
+			MethodName: "GetAlbum",
+			Handler:    _AlbumService_GetAlbum_Handler,
+		},
+		{
+			MethodName: "ListAlbums",
+			Handler:    _AlbumService_ListAlbums_Handler,
+		},
+		{
+			MethodName: "RenameAlbum",
+			Handler:    _AlbumService_RenameAlbum_Handler,
+		},
+		{
+			MethodName: "DeleteAlbum",
+			Handler:    _AlbumService_DeleteAlbum_Handler,
+		},
+		{
+			MethodName: "AddAlbumImages",
+			Handler:    _AlbumService_AddAlbumImages_Handler,
+		},
+		{
+			MethodName: "RemoveAlbumImages",
+			Handler:    _AlbumService_RemoveAlbumImages_Handler,
+		},
+		{
+			MethodName: "ReorderAlbumImages",
+			Handler:    _AlbumService_ReorderAlbumImages_Handler,
+		},
+		{
+			MethodName: "SetAlbumCover",
+			Handler:    _AlbumService_SetAlbumCover_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/album.proto",
+}
@@ -0,0 +1,462 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/audit.proto
+
+package audit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on AuditLog with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuditLog) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuditLog with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuditLogMultiError, or nil
+// if none found.
+func (m *AuditLog) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuditLog) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AuditId
+
+	// no validation rules for Action
+
+	// no validation rules for Method
+
+	// no validation rules for ActorId
+
+	// no validation rules for ResourceType
+
+	// no validation rules for RequestId
+
+	// no validation rules for Ip
+
+	// no validation rules for Outcome
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for Detail
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return AuditLogMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuditLogMultiError is an error wrapping multiple validation errors returned
+// by AuditLog.ValidateAll() if the designated constraints aren't met.
+type AuditLogMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuditLogMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuditLogMultiError) AllErrors() []error { return m }
+
+// AuditLogValidationError is the validation error returned by
+// AuditLog.Validate if the designated constraints aren't met.
+type AuditLogValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuditLogValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuditLogValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuditLogValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuditLogValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuditLogValidationError) ErrorName() string { return "AuditLogValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuditLogValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuditLog.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuditLogValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuditLogValidationError{}
+
+// Validate checks the field values on QueryAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryAuditLogRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryAuditLogRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryAuditLogRequestMultiError, or nil if none found.
+func (m *QueryAuditLogRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryAuditLogRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ActorId
+
+	// no validation rules for Action
+
+	if m.GetTargetId() != "" {
+
+		if utf8.RuneCountInString(m.GetTargetId()) > 100 {
+			err := QueryAuditLogRequestValidationError{
+				field:  "TargetId",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetOutcome() != "" {
+
+		if _, ok := _QueryAuditLogRequest_Outcome_InLookup[m.GetOutcome()]; !ok {
+			err := QueryAuditLogRequestValidationError{
+				field:  "Outcome",
+				reason: "value must be in list [success failure]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for RequestId
+
+	// no validation rules for Since
+
+	// no validation rules for Until
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := QueryAuditLogRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := QueryAuditLogRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return QueryAuditLogRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryAuditLogRequestMultiError is an error wrapping multiple validation
+// errors returned by QueryAuditLogRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QueryAuditLogRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryAuditLogRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryAuditLogRequestMultiError) AllErrors() []error { return m }
+
+// QueryAuditLogRequestValidationError is the validation error returned by
+// QueryAuditLogRequest.Validate if the designated constraints aren't met.
+type QueryAuditLogRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryAuditLogRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryAuditLogRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryAuditLogRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryAuditLogRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryAuditLogRequestValidationError) ErrorName() string {
+	return "QueryAuditLogRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryAuditLogRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryAuditLogRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryAuditLogRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryAuditLogRequestValidationError{}
+
+var _QueryAuditLogRequest_Outcome_InLookup = map[string]struct{}{
+	"success": {},
+	"failure": {},
+}
+
+// Validate checks the field values on QueryAuditLogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QueryAuditLogResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryAuditLogResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryAuditLogResponseMultiError, or nil if none found.
+func (m *QueryAuditLogResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryAuditLogResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLogs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QueryAuditLogResponseValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QueryAuditLogResponseValidationError{
+						field:  fmt.Sprintf("Logs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QueryAuditLogResponseValidationError{
+					field:  fmt.Sprintf("Logs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QueryAuditLogResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryAuditLogResponseMultiError is an error wrapping multiple validation
+// errors returned by QueryAuditLogResponse.ValidateAll() if the designated
+// constraints aren't met.
+type QueryAuditLogResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryAuditLogResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryAuditLogResponseMultiError) AllErrors() []error { return m }
+
+// QueryAuditLogResponseValidationError is the validation error returned by
+// QueryAuditLogResponse.Validate if the designated constraints aren't met.
+type QueryAuditLogResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryAuditLogResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryAuditLogResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryAuditLogResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryAuditLogResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryAuditLogResponseValidationError) ErrorName() string {
+	return "QueryAuditLogResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QueryAuditLogResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryAuditLogResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QueryAuditLogResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryAuditLogResponseValidationError{}
@@ -0,0 +1,801 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/blocklist.proto
+
+package blocklist
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on BlockedHash with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlockedHash) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlockedHash with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlockedHashMultiError, or
+// nil if none found.
+func (m *BlockedHash) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlockedHash) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HashId
+
+	// no validation rules for Type
+
+	// no validation rules for Hash
+
+	// no validation rules for Reason
+
+	// no validation rules for Source
+
+	// no validation rules for CreatedBy
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return BlockedHashMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlockedHashMultiError is an error wrapping multiple validation errors
+// returned by BlockedHash.ValidateAll() if the designated constraints aren't met.
+type BlockedHashMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlockedHashMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlockedHashMultiError) AllErrors() []error { return m }
+
+// BlockedHashValidationError is the validation error returned by
+// BlockedHash.Validate if the designated constraints aren't met.
+type BlockedHashValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlockedHashValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlockedHashValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlockedHashValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlockedHashValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlockedHashValidationError) ErrorName() string { return "BlockedHashValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlockedHashValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlockedHash.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlockedHashValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlockedHashValidationError{}
+
+// Validate checks the field values on AddBlockedHashRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddBlockedHashRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddBlockedHashRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddBlockedHashRequestMultiError, or nil if none found.
+func (m *AddBlockedHashRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddBlockedHashRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _AddBlockedHashRequest_Type_InLookup[m.GetType()]; !ok {
+		err := AddBlockedHashRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [sha256 phash]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddBlockedHashRequest_Hash_Pattern.MatchString(m.GetHash()) {
+		err := AddBlockedHashRequestValidationError{
+			field:  "Hash",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{16}([a-fA-F0-9]{48})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 200 {
+		err := AddBlockedHashRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSource()) > 100 {
+		err := AddBlockedHashRequestValidationError{
+			field:  "Source",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddBlockedHashRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddBlockedHashRequestMultiError is an error wrapping multiple validation
+// errors returned by AddBlockedHashRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AddBlockedHashRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddBlockedHashRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddBlockedHashRequestMultiError) AllErrors() []error { return m }
+
+// AddBlockedHashRequestValidationError is the validation error returned by
+// AddBlockedHashRequest.Validate if the designated constraints aren't met.
+type AddBlockedHashRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddBlockedHashRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddBlockedHashRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddBlockedHashRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddBlockedHashRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddBlockedHashRequestValidationError) ErrorName() string {
+	return "AddBlockedHashRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddBlockedHashRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddBlockedHashRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddBlockedHashRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddBlockedHashRequestValidationError{}
+
+var _AddBlockedHashRequest_Type_InLookup = map[string]struct{}{
+	"sha256": {},
+	"phash":  {},
+}
+
+var _AddBlockedHashRequest_Hash_Pattern = regexp.MustCompile("^[a-fA-F0-9]{16}([a-fA-F0-9]{48})?$")
+
+// Validate checks the field values on ListBlockedHashesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBlockedHashesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBlockedHashesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBlockedHashesRequestMultiError, or nil if none found.
+func (m *ListBlockedHashesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBlockedHashesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetType() != "" {
+
+		if _, ok := _ListBlockedHashesRequest_Type_InLookup[m.GetType()]; !ok {
+			err := ListBlockedHashesRequestValidationError{
+				field:  "Type",
+				reason: "value must be in list [sha256 phash]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListBlockedHashesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := ListBlockedHashesRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListBlockedHashesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBlockedHashesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListBlockedHashesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListBlockedHashesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBlockedHashesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBlockedHashesRequestMultiError) AllErrors() []error { return m }
+
+// ListBlockedHashesRequestValidationError is the validation error returned by
+// ListBlockedHashesRequest.Validate if the designated constraints aren't met.
+type ListBlockedHashesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBlockedHashesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBlockedHashesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBlockedHashesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBlockedHashesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBlockedHashesRequestValidationError) ErrorName() string {
+	return "ListBlockedHashesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBlockedHashesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBlockedHashesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBlockedHashesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBlockedHashesRequestValidationError{}
+
+var _ListBlockedHashesRequest_Type_InLookup = map[string]struct{}{
+	"sha256": {},
+	"phash":  {},
+}
+
+// Validate checks the field values on ListBlockedHashesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListBlockedHashesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBlockedHashesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBlockedHashesResponseMultiError, or nil if none found.
+func (m *ListBlockedHashesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBlockedHashesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHashes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBlockedHashesResponseValidationError{
+						field:  fmt.Sprintf("Hashes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBlockedHashesResponseValidationError{
+						field:  fmt.Sprintf("Hashes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBlockedHashesResponseValidationError{
+					field:  fmt.Sprintf("Hashes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListBlockedHashesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBlockedHashesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBlockedHashesResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListBlockedHashesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBlockedHashesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBlockedHashesResponseMultiError) AllErrors() []error { return m }
+
+// ListBlockedHashesResponseValidationError is the validation error returned by
+// ListBlockedHashesResponse.Validate if the designated constraints aren't met.
+type ListBlockedHashesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBlockedHashesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBlockedHashesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBlockedHashesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBlockedHashesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBlockedHashesResponseValidationError) ErrorName() string {
+	return "ListBlockedHashesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBlockedHashesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBlockedHashesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBlockedHashesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBlockedHashesResponseValidationError{}
+
+// Validate checks the field values on DeleteBlockedHashRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBlockedHashRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBlockedHashRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBlockedHashRequestMultiError, or nil if none found.
+func (m *DeleteBlockedHashRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBlockedHashRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_DeleteBlockedHashRequest_HashId_Pattern.MatchString(m.GetHashId()) {
+		err := DeleteBlockedHashRequestValidationError{
+			field:  "HashId",
+			reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteBlockedHashRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBlockedHashRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBlockedHashRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBlockedHashRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBlockedHashRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBlockedHashRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBlockedHashRequestValidationError is the validation error returned by
+// DeleteBlockedHashRequest.Validate if the designated constraints aren't met.
+type DeleteBlockedHashRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBlockedHashRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBlockedHashRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBlockedHashRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBlockedHashRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBlockedHashRequestValidationError) ErrorName() string {
+	return "DeleteBlockedHashRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBlockedHashRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBlockedHashRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBlockedHashRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBlockedHashRequestValidationError{}
+
+var _DeleteBlockedHashRequest_HashId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+// Validate checks the field values on DeleteBlockedHashResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteBlockedHashResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBlockedHashResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBlockedHashResponseMultiError, or nil if none found.
+func (m *DeleteBlockedHashResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBlockedHashResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteBlockedHashResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBlockedHashResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteBlockedHashResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteBlockedHashResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBlockedHashResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBlockedHashResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBlockedHashResponseValidationError is the validation error returned by
+// DeleteBlockedHashResponse.Validate if the designated constraints aren't met.
+type DeleteBlockedHashResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBlockedHashResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBlockedHashResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBlockedHashResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBlockedHashResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBlockedHashResponseValidationError) ErrorName() string {
+	return "DeleteBlockedHashResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBlockedHashResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBlockedHashResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBlockedHashResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBlockedHashResponseValidationError{}
@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/blocklist.proto
+
+package blocklist
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BlocklistService_AddBlockedHash_FullMethodName    = "/mediaService.BlocklistService/AddBlockedHash"
+	BlocklistService_ListBlockedHashes_FullMethodName = "/mediaService.BlocklistService/ListBlockedHashes"
+	BlocklistService_DeleteBlockedHash_FullMethodName = "/mediaService.BlocklistService/DeleteBlockedHash"
+)
+
+// BlocklistServiceClient is the client API for BlocklistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BlocklistServiceClient interface {
+	// 新增封鎖雜湊
+	AddBlockedHash(ctx context.Context, in *AddBlockedHashRequest, opts ...grpc.CallOption) (*BlockedHash, error)
+	// 列出封鎖雜湊
+	ListBlockedHashes(ctx context.Context, in *ListBlockedHashesRequest, opts ...grpc.CallOption) (*ListBlockedHashesResponse, error)
+	// 刪除封鎖雜湊
+	DeleteBlockedHash(ctx context.Context, in *DeleteBlockedHashRequest, opts ...grpc.CallOption) (*DeleteBlockedHashResponse, error)
+}
+
+type blocklistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlocklistServiceClient(cc grpc.ClientConnInterface) BlocklistServiceClient {
+	return &blocklistServiceClient{cc}
+}
+
+func (c *blocklistServiceClient) AddBlockedHash(ctx context.Context, in *AddBlockedHashRequest, opts ...grpc.CallOption) (*BlockedHash, error) {
+	out := new(BlockedHash)
+	err := c.cc.Invoke(ctx, BlocklistService_AddBlockedHash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blocklistServiceClient) ListBlockedHashes(ctx context.Context, in *ListBlockedHashesRequest, opts ...grpc.CallOption) (*ListBlockedHashesResponse, error) {
+	out := new(ListBlockedHashesResponse)
+	err := c.cc.Invoke(ctx, BlocklistService_ListBlockedHashes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blocklistServiceClient) DeleteBlockedHash(ctx context.Context, in *DeleteBlockedHashRequest, opts ...grpc.CallOption) (*DeleteBlockedHashResponse, error) {
+	out := new(DeleteBlockedHashResponse)
+	err := c.cc.Invoke(ctx, BlocklistService_DeleteBlockedHash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlocklistServiceServer is the server API for BlocklistService service.
+// All implementations must embed UnimplementedBlocklistServiceServer
+// for forward compatibility
+type BlocklistServiceServer interface {
+	// 新增封鎖雜湊
+	AddBlockedHash(context.Context, *AddBlockedHashRequest) (*BlockedHash, error)
+	// 列出封鎖雜湊
+	ListBlockedHashes(context.Context, *ListBlockedHashesRequest) (*ListBlockedHashesResponse, error)
+	// 刪除封鎖雜湊
+	DeleteBlockedHash(context.Context, *DeleteBlockedHashRequest) (*DeleteBlockedHashResponse, error)
+	mustEmbedUnimplementedBlocklistServiceServer()
+}
+
+// UnimplementedBlocklistServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBlocklistServiceServer struct {
+}
+
+func (UnimplementedBlocklistServiceServer) AddBlockedHash(context.Context, *AddBlockedHashRequest) (*BlockedHash, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedHash not implemented")
+}
+func (UnimplementedBlocklistServiceServer) ListBlockedHashes(context.Context, *ListBlockedHashesRequest) (*ListBlockedHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedHashes not implemented")
+}
+func (UnimplementedBlocklistServiceServer) DeleteBlockedHash(context.Context, *DeleteBlockedHashRequest) (*DeleteBlockedHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBlockedHash not implemented")
+}
+func (UnimplementedBlocklistServiceServer) mustEmbedUnimplementedBlocklistServiceServer() {}
+
+// UnsafeBlocklistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlocklistServiceServer will
+// result in compilation errors.
+type UnsafeBlocklistServiceServer interface {
+	mustEmbedUnimplementedBlocklistServiceServer()
+}
+
+func RegisterBlocklistServiceServer(s grpc.ServiceRegistrar, srv BlocklistServiceServer) {
+	s.RegisterService(&BlocklistService_ServiceDesc, srv)
+}
+
+func _BlocklistService_AddBlockedHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBlockedHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlocklistServiceServer).AddBlockedHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlocklistService_AddBlockedHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlocklistServiceServer).AddBlockedHash(ctx, req.(*AddBlockedHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlocklistService_ListBlockedHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlocklistServiceServer).ListBlockedHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlocklistService_ListBlockedHashes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlocklistServiceServer).ListBlockedHashes(ctx, req.(*ListBlockedHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlocklistService_DeleteBlockedHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlockedHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlocklistServiceServer).DeleteBlockedHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlocklistService_DeleteBlockedHash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlocklistServiceServer).DeleteBlockedHash(ctx, req.(*DeleteBlockedHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BlocklistService_ServiceDesc is the grpc.ServiceDesc for BlocklistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlocklistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mediaService.BlocklistService",
+	HandlerType: (*BlocklistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddBlockedHash",
+			Handler:    _BlocklistService_AddBlockedHash_Handler,
+		},
+		{
+			MethodName: "ListBlockedHashes",
+			Handler:    _BlocklistService_ListBlockedHashes_Handler,
+		},
+		{
+			MethodName: "DeleteBlockedHash",
+			Handler:    _BlocklistService_DeleteBlockedHash_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/blocklist.proto",
+}
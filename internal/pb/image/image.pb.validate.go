@@ -196,6 +196,187 @@ var _ImageMetadata_Format_NotInLookup = map[ImageFormat]struct{}{
 
 var _ImageMetadata_UploadTime_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}Z$")
 
+// Validate checks the field values on ImageInfo with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImageInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImageInfoMultiError, or nil
+// if none found.
+func (m *ImageInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetTitle()) > 200 {
+		err := ImageInfoValidationError{
+			field:  "Title",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 2000 {
+		err := ImageInfoValidationError{
+			field:  "Description",
+			reason: "value length must be at most 2000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAltText()) > 500 {
+		err := ImageInfoValidationError{
+			field:  "AltText",
+			reason: "value length must be at most 500 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCopyright()) > 200 {
+		err := ImageInfoValidationError{
+			field:  "Copyright",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLicense()) > 100 {
+		err := ImageInfoValidationError{
+			field:  "License",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTags()) > 20 {
+		err := ImageInfoValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTags() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 32 {
+			err := ImageInfoValidationError{
+				field:  fmt.Sprintf("Tags[%v]", idx),
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImageInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageInfoMultiError is an error wrapping multiple validation errors returned
+// by ImageInfo.ValidateAll() if the designated constraints aren't met.
+type ImageInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageInfoMultiError) AllErrors() []error { return m }
+
+// ImageInfoValidationError is the validation error returned by
+// ImageInfo.Validate if the designated constraints aren't met.
+type ImageInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageInfoValidationError) ErrorName() string { return "ImageInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImageInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageInfoValidationError{}
+
 // Validate checks the field values on UploadRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -407,6 +588,35 @@ func (m *UploadImage) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UploadImageValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UploadImageValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UploadImageValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Latitude != nil {
 
 		if m.GetLatitude() != 0 {
@@ -726,6 +936,35 @@ func (m *SignedUrl) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SignedUrlValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SignedUrlValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SignedUrlValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return SignedUrlMultiError(errors)
 	}
@@ -1147,6 +1386,37 @@ func (m *ImageStatus) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ImageStatusValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ImageStatusValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ImageStatusValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ModerationStatus
+
 	if len(errors) > 0 {
 		return ImageStatusMultiError(errors)
 	}
@@ -1471,6 +1741,8 @@ func (m *DeleteRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Force
+
 	if len(errors) > 0 {
 		return DeleteRequestMultiError(errors)
 	}
@@ -1713,6 +1985,8 @@ func (m *BatchDeleteRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for Force
+
 	if len(errors) > 0 {
 		return BatchDeleteRequestMultiError(errors)
 	}
@@ -1932,10 +2206,72 @@ func (m *ImageRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetVariant()) < 1 {
+	// no validation rules for Variant
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	if m.GetFit() != "" {
+
+		if _, ok := _ImageRequest_Fit_InLookup[m.GetFit()]; !ok {
+			err := ImageRequestValidationError{
+				field:  "Fit",
+				reason: "value must be in list [scale-down contain cover crop pad]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetGravity() != "" {
+
+		if !_ImageRequest_Gravity_Pattern.MatchString(m.GetGravity()) {
+			err := ImageRequestValidationError{
+				field:  "Gravity",
+				reason: "value does not match regex pattern \"^(auto|left|right|top|bottom|0(\\\\.[0-9]+)?x0(\\\\.[0-9]+)?|1x1)$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetQuality() > 100 {
 		err := ImageRequestValidationError{
-			field:  "Variant",
-			reason: "value length must be at least 1 runes",
+			field:  "Quality",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFormat() != "" {
+
+		if _, ok := _ImageRequest_Format_InLookup[m.GetFormat()]; !ok {
+			err := ImageRequestValidationError{
+				field:  "Format",
+				reason: "value must be in list [auto avif webp jpeg png]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetDpr(); val < 0 || val > 3 {
+		err := ImageRequestValidationError{
+			field:  "Dpr",
+			reason: "value must be inside range [0, 3]",
 		}
 		if !all {
 			return err
@@ -2022,6 +2358,24 @@ var _ interface {
 
 var _ImageRequest_Id_Pattern = regexp.MustCompile("^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}$")
 
+var _ImageRequest_Fit_InLookup = map[string]struct{}{
+	"scale-down": {},
+	"contain":    {},
+	"cover":      {},
+	"crop":       {},
+	"pad":        {},
+}
+
+var _ImageRequest_Gravity_Pattern = regexp.MustCompile("^(auto|left|right|top|bottom|0(\\.[0-9]+)?x0(\\.[0-9]+)?|1x1)$")
+
+var _ImageRequest_Format_InLookup = map[string]struct{}{
+	"auto": {},
+	"avif": {},
+	"webp": {},
+	"jpeg": {},
+	"png":  {},
+}
+
 // Validate checks the field values on ImageResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2123,3 +2477,5910 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ImageResponseValidationError{}
+
+// Validate checks the field values on ImageStatsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImageStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImageStatsRequestMultiError, or nil if none found.
+func (m *ImageStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ImageStatsRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := ImageStatsRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Granularity
+
+	if !_ImageStatsRequest_StartTime_Pattern.MatchString(m.GetStartTime()) {
+		err := ImageStatsRequestValidationError{
+			field:  "StartTime",
+			reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}Z$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ImageStatsRequest_EndTime_Pattern.MatchString(m.GetEndTime()) {
+		err := ImageStatsRequestValidationError{
+			field:  "EndTime",
+			reason: "value does not match regex pattern \"^\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}Z$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImageStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageStatsRequestMultiError is an error wrapping multiple validation errors
+// returned by ImageStatsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImageStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageStatsRequestMultiError) AllErrors() []error { return m }
+
+// ImageStatsRequestValidationError is the validation error returned by
+// ImageStatsRequest.Validate if the designated constraints aren't met.
+type ImageStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageStatsRequestValidationError) ErrorName() string {
+	return "ImageStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImageStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageStatsRequestValidationError{}
+
+var _ImageStatsRequest_ImageId_Pattern = regexp.MustCompile("^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}$")
+
+var _ImageStatsRequest_StartTime_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}Z$")
+
+var _ImageStatsRequest_EndTime_Pattern = regexp.MustCompile("^\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}Z$")
+
+// Validate checks the field values on StatsPoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatsPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsPoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatsPointMultiError, or
+// nil if none found.
+func (m *StatsPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Time
+
+	// no validation rules for Views
+
+	if len(errors) > 0 {
+		return StatsPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsPointMultiError is an error wrapping multiple validation errors
+// returned by StatsPoint.ValidateAll() if the designated constraints aren't met.
+type StatsPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsPointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsPointMultiError) AllErrors() []error { return m }
+
+// StatsPointValidationError is the validation error returned by
+// StatsPoint.Validate if the designated constraints aren't met.
+type StatsPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsPointValidationError) ErrorName() string { return "StatsPointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsPointValidationError{}
+
+// Validate checks the field values on ImageStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImageStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImageStatsResponseMultiError, or nil if none found.
+func (m *ImageStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for Granularity
+
+	for idx, item := range m.GetPoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImageStatsResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImageStatsResponseValidationError{
+						field:  fmt.Sprintf("Points[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImageStatsResponseValidationError{
+					field:  fmt.Sprintf("Points[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalViews
+
+	if len(errors) > 0 {
+		return ImageStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageStatsResponseMultiError is an error wrapping multiple validation errors
+// returned by ImageStatsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ImageStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageStatsResponseMultiError) AllErrors() []error { return m }
+
+// ImageStatsResponseValidationError is the validation error returned by
+// ImageStatsResponse.Validate if the designated constraints aren't met.
+type ImageStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageStatsResponseValidationError) ErrorName() string {
+	return "ImageStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImageStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageStatsResponseValidationError{}
+
+// Validate checks the field values on ListTopImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTopImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTopImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTopImagesRequestMultiError, or nil if none found.
+func (m *ListTopImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTopImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Period
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListTopImagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OwnerId
+
+	if len(m.GetImageIds()) > 100 {
+		err := ListTopImagesRequestValidationError{
+			field:  "ImageIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTopImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTopImagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTopImagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTopImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTopImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTopImagesRequestMultiError) AllErrors() []error { return m }
+
+// ListTopImagesRequestValidationError is the validation error returned by
+// ListTopImagesRequest.Validate if the designated constraints aren't met.
+type ListTopImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTopImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTopImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTopImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTopImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTopImagesRequestValidationError) ErrorName() string {
+	return "ListTopImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTopImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTopImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTopImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTopImagesRequestValidationError{}
+
+// Validate checks the field values on ListTrendingImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTrendingImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTrendingImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTrendingImagesRequestMultiError, or nil if none found.
+func (m *ListTrendingImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTrendingImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListTrendingImagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OwnerId
+
+	if len(m.GetImageIds()) > 100 {
+		err := ListTrendingImagesRequestValidationError{
+			field:  "ImageIds",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTrendingImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTrendingImagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTrendingImagesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListTrendingImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTrendingImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTrendingImagesRequestMultiError) AllErrors() []error { return m }
+
+// ListTrendingImagesRequestValidationError is the validation error returned by
+// ListTrendingImagesRequest.Validate if the designated constraints aren't met.
+type ListTrendingImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTrendingImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTrendingImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTrendingImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTrendingImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTrendingImagesRequestValidationError) ErrorName() string {
+	return "ListTrendingImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTrendingImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTrendingImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTrendingImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTrendingImagesRequestValidationError{}
+
+// Validate checks the field values on RankedImage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RankedImage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RankedImage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RankedImageMultiError, or
+// nil if none found.
+func (m *RankedImage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RankedImage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for Score
+
+	// no validation rules for Variants
+
+	if len(errors) > 0 {
+		return RankedImageMultiError(errors)
+	}
+
+	return nil
+}
+
+// RankedImageMultiError is an error wrapping multiple validation errors
+// returned by RankedImage.ValidateAll() if the designated constraints aren't met.
+type RankedImageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RankedImageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RankedImageMultiError) AllErrors() []error { return m }
+
+// RankedImageValidationError is the validation error returned by
+// RankedImage.Validate if the designated constraints aren't met.
+type RankedImageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RankedImageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RankedImageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RankedImageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RankedImageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RankedImageValidationError) ErrorName() string { return "RankedImageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RankedImageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRankedImage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RankedImageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RankedImageValidationError{}
+
+// Validate checks the field values on RankedImagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RankedImagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RankedImagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RankedImagesResponseMultiError, or nil if none found.
+func (m *RankedImagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RankedImagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetImages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RankedImagesResponseValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RankedImagesResponseValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RankedImagesResponseValidationError{
+					field:  fmt.Sprintf("Images[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RankedImagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RankedImagesResponseMultiError is an error wrapping multiple validation
+// errors returned by RankedImagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RankedImagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RankedImagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RankedImagesResponseMultiError) AllErrors() []error { return m }
+
+// RankedImagesResponseValidationError is the validation error returned by
+// RankedImagesResponse.Validate if the designated constraints aren't met.
+type RankedImagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RankedImagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RankedImagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RankedImagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RankedImagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RankedImagesResponseValidationError) ErrorName() string {
+	return "RankedImagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RankedImagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRankedImagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RankedImagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RankedImagesResponseValidationError{}
+
+// Validate checks the field values on StorageUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageUsageRequestMultiError, or nil if none found.
+func (m *StorageUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StorageUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by StorageUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StorageUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageUsageRequestMultiError) AllErrors() []error { return m }
+
+// StorageUsageRequestValidationError is the validation error returned by
+// StorageUsageRequest.Validate if the designated constraints aren't met.
+type StorageUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageUsageRequestValidationError) ErrorName() string {
+	return "StorageUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageUsageRequestValidationError{}
+
+// Validate checks the field values on FormatUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FormatUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FormatUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FormatUsageMultiError, or
+// nil if none found.
+func (m *FormatUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FormatUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageCount
+
+	// no validation rules for TotalBytes
+
+	if len(errors) > 0 {
+		return FormatUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// FormatUsageMultiError is an error wrapping multiple validation errors
+// returned by FormatUsage.ValidateAll() if the designated constraints aren't met.
+type FormatUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FormatUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FormatUsageMultiError) AllErrors() []error { return m }
+
+// FormatUsageValidationError is the validation error returned by
+// FormatUsage.Validate if the designated constraints aren't met.
+type FormatUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FormatUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FormatUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FormatUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FormatUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FormatUsageValidationError) ErrorName() string { return "FormatUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FormatUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFormatUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FormatUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FormatUsageValidationError{}
+
+// Validate checks the field values on StorageQuota with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StorageQuota) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageQuota with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StorageQuotaMultiError, or
+// nil if none found.
+func (m *StorageQuota) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageQuota) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Role
+
+	// no validation rules for MaxBytes
+
+	// no validation rules for MaxImages
+
+	if len(errors) > 0 {
+		return StorageQuotaMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageQuotaMultiError is an error wrapping multiple validation errors
+// returned by StorageQuota.ValidateAll() if the designated constraints aren't met.
+type StorageQuotaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageQuotaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageQuotaMultiError) AllErrors() []error { return m }
+
+// StorageQuotaValidationError is the validation error returned by
+// StorageQuota.Validate if the designated constraints aren't met.
+type StorageQuotaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageQuotaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageQuotaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageQuotaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageQuotaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageQuotaValidationError) ErrorName() string { return "StorageQuotaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageQuotaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageQuota.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageQuotaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageQuotaValidationError{}
+
+// Validate checks the field values on StorageUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StorageUsageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageUsageResponseMultiError, or nil if none found.
+func (m *StorageUsageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageUsageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OwnerId
+
+	// no validation rules for ImageCount
+
+	// no validation rules for TotalBytes
+
+	{
+		sorted_keys := make([]string, len(m.GetByFormat()))
+		i := 0
+		for key := range m.GetByFormat() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetByFormat()[key]
+			_ = val
+
+			// no validation rules for ByFormat[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, StorageUsageResponseValidationError{
+							field:  fmt.Sprintf("ByFormat[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, StorageUsageResponseValidationError{
+							field:  fmt.Sprintf("ByFormat[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return StorageUsageResponseValidationError{
+						field:  fmt.Sprintf("ByFormat[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageUsageResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageUsageResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageUsageResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StorageUsageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by StorageUsageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StorageUsageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageUsageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageUsageResponseMultiError) AllErrors() []error { return m }
+
+// StorageUsageResponseValidationError is the validation error returned by
+// StorageUsageResponse.Validate if the designated constraints aren't met.
+type StorageUsageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageUsageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageUsageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageUsageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageUsageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageUsageResponseValidationError) ErrorName() string {
+	return "StorageUsageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageUsageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageUsageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageUsageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageUsageResponseValidationError{}
+
+// Validate checks the field values on ImageReference with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImageReference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageReference with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImageReferenceMultiError,
+// or nil if none found.
+func (m *ImageReference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageReference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Service
+
+	// no validation rules for EntityType
+
+	// no validation rules for EntityId
+
+	// no validation rules for AttachedBy
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return ImageReferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageReferenceMultiError is an error wrapping multiple validation errors
+// returned by ImageReference.ValidateAll() if the designated constraints
+// aren't met.
+type ImageReferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageReferenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageReferenceMultiError) AllErrors() []error { return m }
+
+// ImageReferenceValidationError is the validation error returned by
+// ImageReference.Validate if the designated constraints aren't met.
+type ImageReferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageReferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageReferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageReferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageReferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageReferenceValidationError) ErrorName() string { return "ImageReferenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImageReferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageReference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageReferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageReferenceValidationError{}
+
+// Validate checks the field values on ImageReferenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImageReferenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageReferenceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImageReferenceRequestMultiError, or nil if none found.
+func (m *ImageReferenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageReferenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := ImageReferenceRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ImageReferenceRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := ImageReferenceRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetService()); l < 1 || l > 64 {
+		err := ImageReferenceRequestValidationError{
+			field:  "Service",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ImageReferenceRequest_Service_Pattern.MatchString(m.GetService()) {
+		err := ImageReferenceRequestValidationError{
+			field:  "Service",
+			reason: "value does not match regex pattern \"^[a-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEntityType()); l < 1 || l > 64 {
+		err := ImageReferenceRequestValidationError{
+			field:  "EntityType",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ImageReferenceRequest_EntityType_Pattern.MatchString(m.GetEntityType()) {
+		err := ImageReferenceRequestValidationError{
+			field:  "EntityType",
+			reason: "value does not match regex pattern \"^[a-z0-9_-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetEntityId()); l < 1 || l > 128 {
+		err := ImageReferenceRequestValidationError{
+			field:  "EntityId",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImageReferenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageReferenceRequestMultiError is an error wrapping multiple validation
+// errors returned by ImageReferenceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImageReferenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageReferenceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageReferenceRequestMultiError) AllErrors() []error { return m }
+
+// ImageReferenceRequestValidationError is the validation error returned by
+// ImageReferenceRequest.Validate if the designated constraints aren't met.
+type ImageReferenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageReferenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageReferenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageReferenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageReferenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageReferenceRequestValidationError) ErrorName() string {
+	return "ImageReferenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImageReferenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageReferenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageReferenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageReferenceRequestValidationError{}
+
+var _ImageReferenceRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+var _ImageReferenceRequest_Service_Pattern = regexp.MustCompile("^[a-z0-9_-]+$")
+
+var _ImageReferenceRequest_EntityType_Pattern = regexp.MustCompile("^[a-z0-9_-]+$")
+
+// Validate checks the field values on ImageReferenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImageReferenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageReferenceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImageReferenceResponseMultiError, or nil if none found.
+func (m *ImageReferenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageReferenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ImageReferenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageReferenceResponseMultiError is an error wrapping multiple validation
+// errors returned by ImageReferenceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImageReferenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageReferenceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageReferenceResponseMultiError) AllErrors() []error { return m }
+
+// ImageReferenceResponseValidationError is the validation error returned by
+// ImageReferenceResponse.Validate if the designated constraints aren't met.
+type ImageReferenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageReferenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageReferenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageReferenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageReferenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageReferenceResponseValidationError) ErrorName() string {
+	return "ImageReferenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImageReferenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageReferenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageReferenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageReferenceResponseValidationError{}
+
+// Validate checks the field values on ImageUsagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImageUsagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageUsagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImageUsagesRequestMultiError, or nil if none found.
+func (m *ImageUsagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageUsagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := ImageUsagesRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ImageUsagesRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := ImageUsagesRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImageUsagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageUsagesRequestMultiError is an error wrapping multiple validation errors
+// returned by ImageUsagesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImageUsagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageUsagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageUsagesRequestMultiError) AllErrors() []error { return m }
+
+// ImageUsagesRequestValidationError is the validation error returned by
+// ImageUsagesRequest.Validate if the designated constraints aren't met.
+type ImageUsagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageUsagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageUsagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageUsagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageUsagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageUsagesRequestValidationError) ErrorName() string {
+	return "ImageUsagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImageUsagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageUsagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageUsagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageUsagesRequestValidationError{}
+
+var _ImageUsagesRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on ImageUsagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImageUsagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageUsagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImageUsagesResponseMultiError, or nil if none found.
+func (m *ImageUsagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageUsagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	for idx, item := range m.GetReferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImageUsagesResponseValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImageUsagesResponseValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImageUsagesResponseValidationError{
+					field:  fmt.Sprintf("References[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImageUsagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageUsagesResponseMultiError is an error wrapping multiple validation
+// errors returned by ImageUsagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImageUsagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageUsagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageUsagesResponseMultiError) AllErrors() []error { return m }
+
+// ImageUsagesResponseValidationError is the validation error returned by
+// ImageUsagesResponse.Validate if the designated constraints aren't met.
+type ImageUsagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageUsagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageUsagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageUsagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageUsagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageUsagesResponseValidationError) ErrorName() string {
+	return "ImageUsagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImageUsagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageUsagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageUsagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageUsagesResponseValidationError{}
+
+// Validate checks the field values on CollectUnusedImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectUnusedImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectUnusedImagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectUnusedImagesRequestMultiError, or nil if none found.
+func (m *CollectUnusedImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectUnusedImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.DryRun != nil {
+		// no validation rules for DryRun
+	}
+
+	if len(errors) > 0 {
+		return CollectUnusedImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectUnusedImagesRequestMultiError is an error wrapping multiple
+// validation errors returned by CollectUnusedImagesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CollectUnusedImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectUnusedImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectUnusedImagesRequestMultiError) AllErrors() []error { return m }
+
+// CollectUnusedImagesRequestValidationError is the validation error returned
+// by CollectUnusedImagesRequest.Validate if the designated constraints aren't met.
+type CollectUnusedImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectUnusedImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectUnusedImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectUnusedImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectUnusedImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectUnusedImagesRequestValidationError) ErrorName() string {
+	return "CollectUnusedImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectUnusedImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectUnusedImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectUnusedImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectUnusedImagesRequestValidationError{}
+
+// Validate checks the field values on CollectUnusedImagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CollectUnusedImagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CollectUnusedImagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CollectUnusedImagesResponseMultiError, or nil if none found.
+func (m *CollectUnusedImagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CollectUnusedImagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	// no validation rules for DeletedCount
+
+	// no validation rules for ReclaimedBytes
+
+	if len(errors) > 0 {
+		return CollectUnusedImagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectUnusedImagesResponseMultiError is an error wrapping multiple
+// validation errors returned by CollectUnusedImagesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CollectUnusedImagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectUnusedImagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectUnusedImagesResponseMultiError) AllErrors() []error { return m }
+
+// CollectUnusedImagesResponseValidationError is the validation error returned
+// by CollectUnusedImagesResponse.Validate if the designated constraints
+// aren't met.
+type CollectUnusedImagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectUnusedImagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectUnusedImagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectUnusedImagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectUnusedImagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectUnusedImagesResponseValidationError) ErrorName() string {
+	return "CollectUnusedImagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CollectUnusedImagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollectUnusedImagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectUnusedImagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectUnusedImagesResponseValidationError{}
+
+// Validate checks the field values on UpdateImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateImageRequestMultiError, or nil if none found.
+func (m *UpdateImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := UpdateImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateImageRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := UpdateImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetInfo() == nil {
+		err := UpdateImageRequestValidationError{
+			field:  "Info",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateImageRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateImageRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateImageRequestValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateImageRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateImageRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateImageRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateImageRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateImageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateImageRequestMultiError) AllErrors() []error { return m }
+
+// UpdateImageRequestValidationError is the validation error returned by
+// UpdateImageRequest.Validate if the designated constraints aren't met.
+type UpdateImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateImageRequestValidationError) ErrorName() string {
+	return "UpdateImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateImageRequestValidationError{}
+
+var _UpdateImageRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on UpdateImageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateImageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateImageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateImageResponseMultiError, or nil if none found.
+func (m *UpdateImageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateImageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateImageResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateImageResponseValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateImageResponseValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateImageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateImageResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateImageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateImageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateImageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateImageResponseMultiError) AllErrors() []error { return m }
+
+// UpdateImageResponseValidationError is the validation error returned by
+// UpdateImageResponse.Validate if the designated constraints aren't met.
+type UpdateImageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateImageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateImageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateImageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateImageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateImageResponseValidationError) ErrorName() string {
+	return "UpdateImageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateImageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateImageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateImageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateImageResponseValidationError{}
+
+// Validate checks the field values on SearchImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchImagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchImagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchImagesRequestMultiError, or nil if none found.
+func (m *SearchImagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchImagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetQuery()) > 200 {
+		err := SearchImagesRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMeta()) > 10 {
+		err := SearchImagesRequestValidationError{
+			field:  "Meta",
+			reason: "value must contain no more than 10 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetMeta()))
+		i := 0
+		for key := range m.GetMeta() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetMeta()[key]
+			_ = val
+
+			if !_SearchImagesRequest_Meta_Pattern.MatchString(key) {
+				err := SearchImagesRequestValidationError{
+					field:  fmt.Sprintf("Meta[%v]", key),
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9_]+$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Meta[key]
+		}
+	}
+
+	// no validation rules for Format
+
+	if len(m.GetTags()) > 10 {
+		err := SearchImagesRequestValidationError{
+			field:  "Tags",
+			reason: "value must contain no more than 10 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SearchImagesRequest_StartTime_Pattern.MatchString(m.GetStartTime()) {
+		err := SearchImagesRequestValidationError{
+			field:  "StartTime",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}Z)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SearchImagesRequest_EndTime_Pattern.MatchString(m.GetEndTime()) {
+		err := SearchImagesRequestValidationError{
+			field:  "EndTime",
+			reason: "value does not match regex pattern \"^(\\\\d{4}-\\\\d{2}-\\\\d{2}T\\\\d{2}:\\\\d{2}:\\\\d{2}Z)?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Sort
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := SearchImagesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Cursor
+
+	if len(errors) > 0 {
+		return SearchImagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchImagesRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchImagesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchImagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchImagesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchImagesRequestMultiError) AllErrors() []error { return m }
+
+// SearchImagesRequestValidationError is the validation error returned by
+// SearchImagesRequest.Validate if the designated constraints aren't met.
+type SearchImagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchImagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchImagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchImagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchImagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchImagesRequestValidationError) ErrorName() string {
+	return "SearchImagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchImagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchImagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchImagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchImagesRequestValidationError{}
+
+var _SearchImagesRequest_Meta_Pattern = regexp.MustCompile("^[a-zA-Z0-9_]+$")
+
+var _SearchImagesRequest_StartTime_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}Z)?$")
+
+var _SearchImagesRequest_EndTime_Pattern = regexp.MustCompile("^(\\d{4}-\\d{2}-\\d{2}T\\d{2}:\\d{2}:\\d{2}Z)?$")
+
+// Validate checks the field values on SearchImageResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SearchImageResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchImageResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchImageResultMultiError, or nil if none found.
+func (m *SearchImageResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchImageResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Filename
+
+	// no validation rules for Uploaded
+
+	// no validation rules for Size
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SearchImageResultValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SearchImageResultValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SearchImageResultValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Variants
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return SearchImageResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchImageResultMultiError is an error wrapping multiple validation errors
+// returned by SearchImageResult.ValidateAll() if the designated constraints
+// aren't met.
+type SearchImageResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchImageResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchImageResultMultiError) AllErrors() []error { return m }
+
+// SearchImageResultValidationError is the validation error returned by
+// SearchImageResult.Validate if the designated constraints aren't met.
+type SearchImageResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchImageResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchImageResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchImageResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchImageResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchImageResultValidationError) ErrorName() string {
+	return "SearchImageResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchImageResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchImageResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchImageResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchImageResultValidationError{}
+
+// Validate checks the field values on SearchImagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchImagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchImagesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchImagesResponseMultiError, or nil if none found.
+func (m *SearchImagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchImagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetImages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchImagesResponseValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchImagesResponseValidationError{
+						field:  fmt.Sprintf("Images[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchImagesResponseValidationError{
+					field:  fmt.Sprintf("Images[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextCursor
+
+	if len(errors) > 0 {
+		return SearchImagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchImagesResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchImagesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchImagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchImagesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchImagesResponseMultiError) AllErrors() []error { return m }
+
+// SearchImagesResponseValidationError is the validation error returned by
+// SearchImagesResponse.Validate if the designated constraints aren't met.
+type SearchImagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchImagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchImagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchImagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchImagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchImagesResponseValidationError) ErrorName() string {
+	return "SearchImagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchImagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchImagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchImagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchImagesResponseValidationError{}
+
+// Validate checks the field values on ReplaceImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaceImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaceImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplaceImageRequestMultiError, or nil if none found.
+func (m *ReplaceImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaceImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := ReplaceImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ReplaceImageRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := ReplaceImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetImage() == nil {
+		err := ReplaceImageRequestValidationError{
+			field:  "Image",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetImage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplaceImageRequestValidationError{
+					field:  "Image",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplaceImageRequestValidationError{
+					field:  "Image",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplaceImageRequestValidationError{
+				field:  "Image",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReplaceImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplaceImageRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplaceImageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplaceImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaceImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaceImageRequestMultiError) AllErrors() []error { return m }
+
+// ReplaceImageRequestValidationError is the validation error returned by
+// ReplaceImageRequest.Validate if the designated constraints aren't met.
+type ReplaceImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaceImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaceImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaceImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaceImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaceImageRequestValidationError) ErrorName() string {
+	return "ReplaceImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaceImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaceImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplaceImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaceImageRequestValidationError{}
+
+var _ReplaceImageRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on ReplaceImageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaceImageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaceImageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplaceImageResponseMultiError, or nil if none found.
+func (m *ReplaceImageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaceImageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for SignedUrl
+
+	// no validation rules for CurrentVersion
+
+	if len(errors) > 0 {
+		return ReplaceImageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplaceImageResponseMultiError is an error wrapping multiple validation
+// errors returned by ReplaceImageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReplaceImageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaceImageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaceImageResponseMultiError) AllErrors() []error { return m }
+
+// ReplaceImageResponseValidationError is the validation error returned by
+// ReplaceImageResponse.Validate if the designated constraints aren't met.
+type ReplaceImageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaceImageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaceImageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaceImageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaceImageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaceImageResponseValidationError) ErrorName() string {
+	return "ReplaceImageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaceImageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaceImageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplaceImageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaceImageResponseValidationError{}
+
+// Validate checks the field values on CompleteReplaceImageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteReplaceImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteReplaceImageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteReplaceImageRequestMultiError, or nil if none found.
+func (m *CompleteReplaceImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteReplaceImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := CompleteReplaceImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CompleteReplaceImageRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := CompleteReplaceImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CompleteReplaceImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteReplaceImageRequestMultiError is an error wrapping multiple
+// validation errors returned by CompleteReplaceImageRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CompleteReplaceImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteReplaceImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteReplaceImageRequestMultiError) AllErrors() []error { return m }
+
+// CompleteReplaceImageRequestValidationError is the validation error returned
+// by CompleteReplaceImageRequest.Validate if the designated constraints
+// aren't met.
+type CompleteReplaceImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteReplaceImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteReplaceImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteReplaceImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteReplaceImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteReplaceImageRequestValidationError) ErrorName() string {
+	return "CompleteReplaceImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteReplaceImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteReplaceImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteReplaceImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteReplaceImageRequestValidationError{}
+
+var _CompleteReplaceImageRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on RollbackImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RollbackImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RollbackImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RollbackImageRequestMultiError, or nil if none found.
+func (m *RollbackImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RollbackImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := RollbackImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_RollbackImageRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := RollbackImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetVersion() <= 0 {
+		err := RollbackImageRequestValidationError{
+			field:  "Version",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RollbackImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RollbackImageRequestMultiError is an error wrapping multiple validation
+// errors returned by RollbackImageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RollbackImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RollbackImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RollbackImageRequestMultiError) AllErrors() []error { return m }
+
+// RollbackImageRequestValidationError is the validation error returned by
+// RollbackImageRequest.Validate if the designated constraints aren't met.
+type RollbackImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackImageRequestValidationError) ErrorName() string {
+	return "RollbackImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackImageRequestValidationError{}
+
+var _RollbackImageRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on ImageVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImageVersionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageVersionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImageVersionResponseMultiError, or nil if none found.
+func (m *ImageVersionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageVersionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for Version
+
+	// no validation rules for Variants
+
+	if len(errors) > 0 {
+		return ImageVersionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageVersionResponseMultiError is an error wrapping multiple validation
+// errors returned by ImageVersionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImageVersionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageVersionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageVersionResponseMultiError) AllErrors() []error { return m }
+
+// ImageVersionResponseValidationError is the validation error returned by
+// ImageVersionResponse.Validate if the designated constraints aren't met.
+type ImageVersionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageVersionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageVersionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageVersionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageVersionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageVersionResponseValidationError) ErrorName() string {
+	return "ImageVersionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImageVersionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageVersionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageVersionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageVersionResponseValidationError{}
+
+// Validate checks the field values on ImageVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImageVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImageVersionMultiError, or
+// nil if none found.
+func (m *ImageVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Filename
+
+	// no validation rules for Size
+
+	// no validation rules for Uploaded
+
+	// no validation rules for ReplacedAt
+
+	if len(errors) > 0 {
+		return ImageVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageVersionMultiError is an error wrapping multiple validation errors
+// returned by ImageVersion.ValidateAll() if the designated constraints aren't met.
+type ImageVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageVersionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageVersionMultiError) AllErrors() []error { return m }
+
+// ImageVersionValidationError is the validation error returned by
+// ImageVersion.Validate if the designated constraints aren't met.
+type ImageVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageVersionValidationError) ErrorName() string { return "ImageVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImageVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageVersionValidationError{}
+
+// Validate checks the field values on ListImageVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListImageVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListImageVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListImageVersionsRequestMultiError, or nil if none found.
+func (m *ListImageVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListImageVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := ListImageVersionsRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ListImageVersionsRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := ListImageVersionsRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListImageVersionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListImageVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListImageVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListImageVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListImageVersionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListImageVersionsRequestMultiError) AllErrors() []error { return m }
+
+// ListImageVersionsRequestValidationError is the validation error returned by
+// ListImageVersionsRequest.Validate if the designated constraints aren't met.
+type ListImageVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListImageVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListImageVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListImageVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListImageVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListImageVersionsRequestValidationError) ErrorName() string {
+	return "ListImageVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListImageVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListImageVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListImageVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListImageVersionsRequestValidationError{}
+
+var _ListImageVersionsRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on ListImageVersionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListImageVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListImageVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListImageVersionsResponseMultiError, or nil if none found.
+func (m *ListImageVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListImageVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for CurrentVersion
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListImageVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListImageVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListImageVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListImageVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListImageVersionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListImageVersionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListImageVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListImageVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListImageVersionsResponseMultiError) AllErrors() []error { return m }
+
+// ListImageVersionsResponseValidationError is the validation error returned by
+// ListImageVersionsResponse.Validate if the designated constraints aren't met.
+type ListImageVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListImageVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListImageVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListImageVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListImageVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListImageVersionsResponseValidationError) ErrorName() string {
+	return "ListImageVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListImageVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListImageVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListImageVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListImageVersionsResponseValidationError{}
+
+// Validate checks the field values on SrcsetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SrcsetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SrcsetRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SrcsetRequestMultiError, or
+// nil if none found.
+func (m *SrcsetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SrcsetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := SrcsetRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_SrcsetRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := SrcsetRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxWidth() > 10000 {
+		err := SrcsetRequestValidationError{
+			field:  "MaxWidth",
+			reason: "value must be less than or equal to 10000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAspectRatio() != "" {
+
+		if !_SrcsetRequest_AspectRatio_Pattern.MatchString(m.GetAspectRatio()) {
+			err := SrcsetRequestValidationError{
+				field:  "AspectRatio",
+				reason: "value does not match regex pattern \"^[0-9]+(\\\\.[0-9]+)?:[0-9]+(\\\\.[0-9]+)?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SrcsetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SrcsetRequestMultiError is an error wrapping multiple validation errors
+// returned by SrcsetRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SrcsetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SrcsetRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SrcsetRequestMultiError) AllErrors() []error { return m }
+
+// SrcsetRequestValidationError is the validation error returned by
+// SrcsetRequest.Validate if the designated constraints aren't met.
+type SrcsetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SrcsetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SrcsetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SrcsetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SrcsetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SrcsetRequestValidationError) ErrorName() string { return "SrcsetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SrcsetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSrcsetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SrcsetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SrcsetRequestValidationError{}
+
+var _SrcsetRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+var _SrcsetRequest_AspectRatio_Pattern = regexp.MustCompile("^[0-9]+(\\.[0-9]+)?:[0-9]+(\\.[0-9]+)?$")
+
+// Validate checks the field values on SrcsetSource with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SrcsetSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SrcsetSource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SrcsetSourceMultiError, or
+// nil if none found.
+func (m *SrcsetSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SrcsetSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Variant
+
+	// no validation rules for Url
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	if len(errors) > 0 {
+		return SrcsetSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// SrcsetSourceMultiError is an error wrapping multiple validation errors
+// returned by SrcsetSource.ValidateAll() if the designated constraints aren't met.
+type SrcsetSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SrcsetSourceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SrcsetSourceMultiError) AllErrors() []error { return m }
+
+// SrcsetSourceValidationError is the validation error returned by
+// SrcsetSource.Validate if the designated constraints aren't met.
+type SrcsetSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SrcsetSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SrcsetSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SrcsetSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SrcsetSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SrcsetSourceValidationError) ErrorName() string { return "SrcsetSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SrcsetSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSrcsetSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SrcsetSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SrcsetSourceValidationError{}
+
+// Validate checks the field values on SrcsetResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SrcsetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SrcsetResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SrcsetResponseMultiError,
+// or nil if none found.
+func (m *SrcsetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SrcsetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for Src
+
+	// no validation rules for Srcset
+
+	// no validation rules for Sizes
+
+	for idx, item := range m.GetSources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SrcsetResponseValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SrcsetResponseValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SrcsetResponseValidationError{
+					field:  fmt.Sprintf("Sources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SrcsetResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SrcsetResponseMultiError is an error wrapping multiple validation errors
+// returned by SrcsetResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SrcsetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SrcsetResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SrcsetResponseMultiError) AllErrors() []error { return m }
+
+// SrcsetResponseValidationError is the validation error returned by
+// SrcsetResponse.Validate if the designated constraints aren't met.
+type SrcsetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SrcsetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SrcsetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SrcsetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SrcsetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SrcsetResponseValidationError) ErrorName() string { return "SrcsetResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SrcsetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSrcsetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SrcsetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SrcsetResponseValidationError{}
+
+// Validate checks the field values on CropOperation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CropOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CropOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CropOperationMultiError, or
+// nil if none found.
+func (m *CropOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CropOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for X
+
+	// no validation rules for Y
+
+	if m.GetWidth() <= 0 {
+		err := CropOperationValidationError{
+			field:  "Width",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHeight() <= 0 {
+		err := CropOperationValidationError{
+			field:  "Height",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CropOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// CropOperationMultiError is an error wrapping multiple validation errors
+// returned by CropOperation.ValidateAll() if the designated constraints
+// aren't met.
+type CropOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CropOperationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CropOperationMultiError) AllErrors() []error { return m }
+
+// CropOperationValidationError is the validation error returned by
+// CropOperation.Validate if the designated constraints aren't met.
+type CropOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CropOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CropOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CropOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CropOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CropOperationValidationError) ErrorName() string { return "CropOperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CropOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCropOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CropOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CropOperationValidationError{}
+
+// Validate checks the field values on RotateOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RotateOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RotateOperation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RotateOperationMultiError, or nil if none found.
+func (m *RotateOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RotateOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _RotateOperation_Degrees_InLookup[m.GetDegrees()]; !ok {
+		err := RotateOperationValidationError{
+			field:  "Degrees",
+			reason: "value must be in list [90 180 270]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RotateOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// RotateOperationMultiError is an error wrapping multiple validation errors
+// returned by RotateOperation.ValidateAll() if the designated constraints
+// aren't met.
+type RotateOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RotateOperationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RotateOperationMultiError) AllErrors() []error { return m }
+
+// RotateOperationValidationError is the validation error returned by
+// RotateOperation.Validate if the designated constraints aren't met.
+type RotateOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RotateOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RotateOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RotateOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RotateOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RotateOperationValidationError) ErrorName() string { return "RotateOperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RotateOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRotateOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RotateOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RotateOperationValidationError{}
+
+var _RotateOperation_Degrees_InLookup = map[uint32]struct{}{
+	90:  {},
+	180: {},
+	270: {},
+}
+
+// Validate checks the field values on AdjustOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AdjustOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AdjustOperation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AdjustOperationMultiError, or nil if none found.
+func (m *AdjustOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AdjustOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetBrightness(); val < 0 || val > 2 {
+		err := AdjustOperationValidationError{
+			field:  "Brightness",
+			reason: "value must be inside range [0, 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetContrast(); val < 0 || val > 2 {
+		err := AdjustOperationValidationError{
+			field:  "Contrast",
+			reason: "value must be inside range [0, 2]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AdjustOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// AdjustOperationMultiError is an error wrapping multiple validation errors
+// returned by AdjustOperation.ValidateAll() if the designated constraints
+// aren't met.
+type AdjustOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AdjustOperationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AdjustOperationMultiError) AllErrors() []error { return m }
+
+// AdjustOperationValidationError is the validation error returned by
+// AdjustOperation.Validate if the designated constraints aren't met.
+type AdjustOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AdjustOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AdjustOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AdjustOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AdjustOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AdjustOperationValidationError) ErrorName() string { return "AdjustOperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AdjustOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAdjustOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AdjustOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AdjustOperationValidationError{}
+
+// Validate checks the field values on BlurOperation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlurOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlurOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlurOperationMultiError, or
+// nil if none found.
+func (m *BlurOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlurOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetRadius(); val < 1 || val > 250 {
+		err := BlurOperationValidationError{
+			field:  "Radius",
+			reason: "value must be inside range [1, 250]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BlurOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlurOperationMultiError is an error wrapping multiple validation errors
+// returned by BlurOperation.ValidateAll() if the designated constraints
+// aren't met.
+type BlurOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlurOperationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlurOperationMultiError) AllErrors() []error { return m }
+
+// BlurOperationValidationError is the validation error returned by
+// BlurOperation.Validate if the designated constraints aren't met.
+type BlurOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlurOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlurOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlurOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlurOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlurOperationValidationError) ErrorName() string { return "BlurOperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlurOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlurOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlurOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlurOperationValidationError{}
+
+// Validate checks the field values on SharpenOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SharpenOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SharpenOperation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SharpenOperationMultiError, or nil if none found.
+func (m *SharpenOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SharpenOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetAmount(); val <= 0 || val > 10 {
+		err := SharpenOperationValidationError{
+			field:  "Amount",
+			reason: "value must be inside range (0, 10]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SharpenOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// SharpenOperationMultiError is an error wrapping multiple validation errors
+// returned by SharpenOperation.ValidateAll() if the designated constraints
+// aren't met.
+type SharpenOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SharpenOperationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SharpenOperationMultiError) AllErrors() []error { return m }
+
+// SharpenOperationValidationError is the validation error returned by
+// SharpenOperation.Validate if the designated constraints aren't met.
+type SharpenOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SharpenOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SharpenOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SharpenOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SharpenOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SharpenOperationValidationError) ErrorName() string { return "SharpenOperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SharpenOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSharpenOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SharpenOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SharpenOperationValidationError{}
+
+// Validate checks the field values on ConvertOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConvertOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConvertOperation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConvertOperationMultiError, or nil if none found.
+func (m *ConvertOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConvertOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ConvertOperation_Format_InLookup[m.GetFormat()]; !ok {
+		err := ConvertOperationValidationError{
+			field:  "Format",
+			reason: "value must be in list [webp jpeg png]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConvertOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConvertOperationMultiError is an error wrapping multiple validation errors
+// returned by ConvertOperation.ValidateAll() if the designated constraints
+// aren't met.
+type ConvertOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConvertOperationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConvertOperationMultiError) AllErrors() []error { return m }
+
+// ConvertOperationValidationError is the validation error returned by
+// ConvertOperation.Validate if the designated constraints aren't met.
+type ConvertOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConvertOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConvertOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConvertOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConvertOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConvertOperationValidationError) ErrorName() string { return "ConvertOperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConvertOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConvertOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConvertOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConvertOperationValidationError{}
+
+var _ConvertOperation_Format_InLookup = map[string]struct{}{
+	"webp": {},
+	"jpeg": {},
+	"png":  {},
+}
+
+// Validate checks the field values on ImageOperation with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImageOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImageOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImageOperationMultiError,
+// or nil if none found.
+func (m *ImageOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImageOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofOperationPresent := false
+	switch v := m.Operation.(type) {
+	case *ImageOperation_Crop:
+		if v == nil {
+			err := ImageOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetCrop()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Crop",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Crop",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCrop()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImageOperationValidationError{
+					field:  "Crop",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImageOperation_Rotate:
+		if v == nil {
+			err := ImageOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetRotate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Rotate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Rotate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRotate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImageOperationValidationError{
+					field:  "Rotate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImageOperation_Adjust:
+		if v == nil {
+			err := ImageOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetAdjust()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Adjust",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Adjust",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAdjust()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImageOperationValidationError{
+					field:  "Adjust",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImageOperation_Blur:
+		if v == nil {
+			err := ImageOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetBlur()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Blur",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Blur",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetBlur()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImageOperationValidationError{
+					field:  "Blur",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImageOperation_Sharpen:
+		if v == nil {
+			err := ImageOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetSharpen()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Sharpen",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Sharpen",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSharpen()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImageOperationValidationError{
+					field:  "Sharpen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ImageOperation_Convert:
+		if v == nil {
+			err := ImageOperationValidationError{
+				field:  "Operation",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOperationPresent = true
+
+		if all {
+			switch v := interface{}(m.GetConvert()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Convert",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImageOperationValidationError{
+						field:  "Convert",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetConvert()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImageOperationValidationError{
+					field:  "Convert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOperationPresent {
+		err := ImageOperationValidationError{
+			field:  "Operation",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ImageOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImageOperationMultiError is an error wrapping multiple validation errors
+// returned by ImageOperation.ValidateAll() if the designated constraints
+// aren't met.
+type ImageOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImageOperationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImageOperationMultiError) AllErrors() []error { return m }
+
+// ImageOperationValidationError is the validation error returned by
+// ImageOperation.Validate if the designated constraints aren't met.
+type ImageOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImageOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImageOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImageOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImageOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImageOperationValidationError) ErrorName() string { return "ImageOperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImageOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImageOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImageOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImageOperationValidationError{}
+
+// Validate checks the field values on ProcessImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProcessImageRequestMultiError, or nil if none found.
+func (m *ProcessImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := ProcessImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ProcessImageRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := ProcessImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetOperations()); l < 1 || l > 6 {
+		err := ProcessImageRequestValidationError{
+			field:  "Operations",
+			reason: "value must contain between 1 and 6 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProcessImageRequestValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProcessImageRequestValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProcessImageRequestValidationError{
+					field:  fmt.Sprintf("Operations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProcessImageRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProcessImageRequestValidationError{
+					field:  "Info",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProcessImageRequestValidationError{
+				field:  "Info",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProcessImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessImageRequestMultiError is an error wrapping multiple validation
+// errors returned by ProcessImageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ProcessImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessImageRequestMultiError) AllErrors() []error { return m }
+
+// ProcessImageRequestValidationError is the validation error returned by
+// ProcessImageRequest.Validate if the designated constraints aren't met.
+type ProcessImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessImageRequestValidationError) ErrorName() string {
+	return "ProcessImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessImageRequestValidationError{}
+
+var _ProcessImageRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on ProcessImageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ProcessImageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProcessImageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ProcessImageResponseMultiError, or nil if none found.
+func (m *ProcessImageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProcessImageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for SourceImageId
+
+	// no validation rules for HistoryId
+
+	// no validation rules for Variants
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProcessImageResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProcessImageResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProcessImageResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ProcessImageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProcessImageResponseMultiError is an error wrapping multiple validation
+// errors returned by ProcessImageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ProcessImageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProcessImageResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProcessImageResponseMultiError) AllErrors() []error { return m }
+
+// ProcessImageResponseValidationError is the validation error returned by
+// ProcessImageResponse.Validate if the designated constraints aren't met.
+type ProcessImageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProcessImageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProcessImageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProcessImageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProcessImageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProcessImageResponseValidationError) ErrorName() string {
+	return "ProcessImageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ProcessImageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProcessImageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProcessImageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProcessImageResponseValidationError{}
@@ -20,13 +20,29 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ImageService_BatchUpload_FullMethodName    = "/mediaService.ImageService/BatchUpload"
-	ImageService_Complete_FullMethodName       = "/mediaService.ImageService/Complete"
-	ImageService_Clear_FullMethodName          = "/mediaService.ImageService/Clear"
-	ImageService_Delete_FullMethodName         = "/mediaService.ImageService/Delete"
-	ImageService_BatchDelete_FullMethodName    = "/mediaService.ImageService/BatchDelete"
-	ImageService_GetImageURI_FullMethodName    = "/mediaService.ImageService/GetImageURI"
-	ImageService_SyncImageCount_FullMethodName = "/mediaService.ImageService/SyncImageCount"
+	ImageService_BatchUpload_FullMethodName          = "/mediaService.ImageService/BatchUpload"
+	ImageService_Complete_FullMethodName             = "/mediaService.ImageService/Complete"
+	ImageService_Clear_FullMethodName                = "/mediaService.ImageService/Clear"
+	ImageService_Delete_FullMethodName               = "/mediaService.ImageService/Delete"
+	ImageService_BatchDelete_FullMethodName          = "/mediaService.ImageService/BatchDelete"
+	ImageService_GetImageURI_FullMethodName          = "/mediaService.ImageService/GetImageURI"
+	ImageService_SyncImageCount_FullMethodName       = "/mediaService.ImageService/SyncImageCount"
+	ImageService_GetImageStats_FullMethodName        = "/mediaService.ImageService/GetImageStats"
+	ImageService_ListTopImages_FullMethodName        = "/mediaService.ImageService/ListTopImages"
+	ImageService_ListTrendingImages_FullMethodName   = "/mediaService.ImageService/ListTrendingImages"
+	ImageService_GetStorageUsage_FullMethodName      = "/mediaService.ImageService/GetStorageUsage"
+	ImageService_AttachImage_FullMethodName          = "/mediaService.ImageService/AttachImage"
+	ImageService_DetachImage_FullMethodName          = "/mediaService.ImageService/DetachImage"
+	ImageService_ListImageUsages_FullMethodName      = "/mediaService.ImageService/ListImageUsages"
+	ImageService_CollectUnusedImages_FullMethodName  = "/mediaService.ImageService/CollectUnusedImages"
+	ImageService_UpdateImage_FullMethodName          = "/mediaService.ImageService/UpdateImage"
+	ImageService_SearchImages_FullMethodName         = "/mediaService.ImageService/SearchImages"
+	ImageService_ReplaceImage_FullMethodName         = "/mediaService.ImageService/ReplaceImage"
+	ImageService_CompleteReplaceImage_FullMethodName = "/mediaService.ImageService/CompleteReplaceImage"
+	ImageService_RollbackImage_FullMethodName        = "/mediaService.ImageService/RollbackImage"
+	ImageService_ListImageVersions_FullMethodName    = "/mediaService.ImageService/ListImageVersions"
+	ImageService_GetImageSrcset_FullMethodName       = "/mediaService.ImageService/GetImageSrcset"
+	ImageService_ProcessImage_FullMethodName         = "/mediaService.ImageService/ProcessImage"
 )
 
 // ImageServiceClient is the client API for ImageService service.
@@ -47,6 +63,38 @@ type ImageServiceClient interface {
 	GetImageURI(ctx context.Context, in *ImageRequest, opts ...grpc.CallOption) (*ImageResponse, error)
 	// 同步圖片計數(Cron Job使用)
 	SyncImageCount(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 取得圖片瀏覽統計
+	GetImageStats(ctx context.Context, in *ImageStatsRequest, opts ...grpc.CallOption) (*ImageStatsResponse, error)
+	// 取得熱門圖片
+	ListTopImages(ctx context.Context, in *ListTopImagesRequest, opts ...grpc.CallOption) (*RankedImagesResponse, error)
+	// 取得趨勢圖片
+	ListTrendingImages(ctx context.Context, in *ListTrendingImagesRequest, opts ...grpc.CallOption) (*RankedImagesResponse, error)
+	// 取得目前使用者的儲存用量與配額
+	GetStorageUsage(ctx context.Context, in *StorageUsageRequest, opts ...grpc.CallOption) (*StorageUsageResponse, error)
+	// 記錄圖片被其他服務的實體引用
+	AttachImage(ctx context.Context, in *ImageReferenceRequest, opts ...grpc.CallOption) (*ImageReferenceResponse, error)
+	// 移除圖片的引用
+	DetachImage(ctx context.Context, in *ImageReferenceRequest, opts ...grpc.CallOption) (*ImageReferenceResponse, error)
+	// 列出引用圖片的實體
+	ListImageUsages(ctx context.Context, in *ImageUsagesRequest, opts ...grpc.CallOption) (*ImageUsagesResponse, error)
+	// 回收超過寬限期且未被引用、不在相簿中的圖片(Cron Job使用，僅限管理員)
+	CollectUnusedImages(ctx context.Context, in *CollectUnusedImagesRequest, opts ...grpc.CallOption) (*CollectUnusedImagesResponse, error)
+	// 更新圖片資訊
+	UpdateImage(ctx context.Context, in *UpdateImageRequest, opts ...grpc.CallOption) (*UpdateImageResponse, error)
+	// 搜尋使用者可以檢視的圖片
+	SearchImages(ctx context.Context, in *SearchImagesRequest, opts ...grpc.CallOption) (*SearchImagesResponse, error)
+	// 取得更換圖片內容的上傳URL，圖片ID維持不變
+	ReplaceImage(ctx context.Context, in *ReplaceImageRequest, opts ...grpc.CallOption) (*ReplaceImageResponse, error)
+	// 新內容上傳完成後切換到新版本
+	CompleteReplaceImage(ctx context.Context, in *CompleteReplaceImageRequest, opts ...grpc.CallOption) (*ImageVersionResponse, error)
+	// 回復到歷史版本
+	RollbackImage(ctx context.Context, in *RollbackImageRequest, opts ...grpc.CallOption) (*ImageVersionResponse, error)
+	// 列出圖片的歷史版本
+	ListImageVersions(ctx context.Context, in *ListImageVersionsRequest, opts ...grpc.CallOption) (*ListImageVersionsResponse, error)
+	// 取得響應式圖片的srcset
+	GetImageSrcset(ctx context.Context, in *SrcsetRequest, opts ...grpc.CallOption) (*SrcsetResponse, error)
+	// 裁切、旋轉、調整圖片並產生新的衍生圖片
+	ProcessImage(ctx context.Context, in *ProcessImageRequest, opts ...grpc.CallOption) (*ProcessImageResponse, error)
 }
 
 type imageServiceClient struct {
@@ -120,6 +168,150 @@ func (c *imageServiceClient) SyncImageCount(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
+func (c *imageServiceClient) GetImageStats(ctx context.Context, in *ImageStatsRequest, opts ...grpc.CallOption) (*ImageStatsResponse, error) {
+	out := new(ImageStatsResponse)
+	err := c.cc.Invoke(ctx, ImageService_GetImageStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ListTopImages(ctx context.Context, in *ListTopImagesRequest, opts ...grpc.CallOption) (*RankedImagesResponse, error) {
+	out := new(RankedImagesResponse)
+	err := c.cc.Invoke(ctx, ImageService_ListTopImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ListTrendingImages(ctx context.Context, in *ListTrendingImagesRequest, opts ...grpc.CallOption) (*RankedImagesResponse, error) {
+	out := new(RankedImagesResponse)
+	err := c.cc.Invoke(ctx, ImageService_ListTrendingImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) GetStorageUsage(ctx context.Context, in *StorageUsageRequest, opts ...grpc.CallOption) (*StorageUsageResponse, error) {
+	out := new(StorageUsageResponse)
+	err := c.cc.Invoke(ctx, ImageService_GetStorageUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) AttachImage(ctx context.Context, in *ImageReferenceRequest, opts ...grpc.CallOption) (*ImageReferenceResponse, error) {
+	out := new(ImageReferenceResponse)
+	err := c.cc.Invoke(ctx, ImageService_AttachImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) DetachImage(ctx context.Context, in *ImageReferenceRequest, opts ...grpc.CallOption) (*ImageReferenceResponse, error) {
+	out := new(ImageReferenceResponse)
+	err := c.cc.Invoke(ctx, ImageService_DetachImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ListImageUsages(ctx context.Context, in *ImageUsagesRequest, opts ...grpc.CallOption) (*ImageUsagesResponse, error) {
+	out := new(ImageUsagesResponse)
+	err := c.cc.Invoke(ctx, ImageService_ListImageUsages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) CollectUnusedImages(ctx context.Context, in *CollectUnusedImagesRequest, opts ...grpc.CallOption) (*CollectUnusedImagesResponse, error) {
+	out := new(CollectUnusedImagesResponse)
+	err := c.cc.Invoke(ctx, ImageService_CollectUnusedImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) UpdateImage(ctx context.Context, in *UpdateImageRequest, opts ...grpc.CallOption) (*UpdateImageResponse, error) {
+	out := new(UpdateImageResponse)
+	err := c.cc.Invoke(ctx, ImageService_UpdateImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) SearchImages(ctx context.Context, in *SearchImagesRequest, opts ...grpc.CallOption) (*SearchImagesResponse, error) {
+	out := new(SearchImagesResponse)
+	err := c.cc.Invoke(ctx, ImageService_SearchImages_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ReplaceImage(ctx context.Context, in *ReplaceImageRequest, opts ...grpc.CallOption) (*ReplaceImageResponse, error) {
+	out := new(ReplaceImageResponse)
+	err := c.cc.Invoke(ctx, ImageService_ReplaceImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) CompleteReplaceImage(ctx context.Context, in *CompleteReplaceImageRequest, opts ...grpc.CallOption) (*ImageVersionResponse, error) {
+	out := new(ImageVersionResponse)
+	err := c.cc.Invoke(ctx, ImageService_CompleteReplaceImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) RollbackImage(ctx context.Context, in *RollbackImageRequest, opts ...grpc.CallOption) (*ImageVersionResponse, error) {
+	out := new(ImageVersionResponse)
+	err := c.cc.Invoke(ctx, ImageService_RollbackImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ListImageVersions(ctx context.Context, in *ListImageVersionsRequest, opts ...grpc.CallOption) (*ListImageVersionsResponse, error) {
+	out := new(ListImageVersionsResponse)
+	err := c.cc.Invoke(ctx, ImageService_ListImageVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) GetImageSrcset(ctx context.Context, in *SrcsetRequest, opts ...grpc.CallOption) (*SrcsetResponse, error) {
+	out := new(SrcsetResponse)
+	err := c.cc.Invoke(ctx, ImageService_GetImageSrcset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *imageServiceClient) ProcessImage(ctx context.Context, in *ProcessImageRequest, opts ...grpc.CallOption) (*ProcessImageResponse, error) {
+	out := new(ProcessImageResponse)
+	err := c.cc.Invoke(ctx, ImageService_ProcessImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ImageServiceServer is the server API for ImageService service.
 // All implementations must embed UnimplementedImageServiceServer
 // for forward compatibility
@@ -138,6 +330,38 @@ type ImageServiceServer interface {
 	GetImageURI(context.Context, *ImageRequest) (*ImageResponse, error)
 	// 同步圖片計數(Cron Job使用)
 	SyncImageCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// 取得圖片瀏覽統計
+	GetImageStats(context.Context, *ImageStatsRequest) (*ImageStatsResponse, error)
+	// 取得熱門圖片
+	ListTopImages(context.Context, *ListTopImagesRequest) (*RankedImagesResponse, error)
+	// 取得趨勢圖片
+	ListTrendingImages(context.Context, *ListTrendingImagesRequest) (*RankedImagesResponse, error)
+	// 取得目前使用者的儲存用量與配額
+	GetStorageUsage(context.Context, *StorageUsageRequest) (*StorageUsageResponse, error)
+	// 記錄圖片被其他服務的實體引用
+	AttachImage(context.Context, *ImageReferenceRequest) (*ImageReferenceResponse, error)
+	// 移除圖片的引用
+	DetachImage(context.Context, *ImageReferenceRequest) (*ImageReferenceResponse, error)
+	// 列出引用圖片的實體
+	ListImageUsages(context.Context, *ImageUsagesRequest) (*ImageUsagesResponse, error)
+	// 回收超過寬限期且未被引用、不在相簿中的圖片(Cron Job使用，僅限管理員)
+	CollectUnusedImages(context.Context, *CollectUnusedImagesRequest) (*CollectUnusedImagesResponse, error)
+	// 更新圖片資訊
+	UpdateImage(context.Context, *UpdateImageRequest) (*UpdateImageResponse, error)
+	// 搜尋使用者可以檢視的圖片
+	SearchImages(context.Context, *SearchImagesRequest) (*SearchImagesResponse, error)
+	// 取得更換圖片內容的上傳URL，圖片ID維持不變
+	ReplaceImage(context.Context, *ReplaceImageRequest) (*ReplaceImageResponse, error)
+	// 新內容上傳完成後切換到新版本
+	CompleteReplaceImage(context.Context, *CompleteReplaceImageRequest) (*ImageVersionResponse, error)
+	// 回復到歷史版本
+	RollbackImage(context.Context, *RollbackImageRequest) (*ImageVersionResponse, error)
+	// 列出圖片的歷史版本
+	ListImageVersions(context.Context, *ListImageVersionsRequest) (*ListImageVersionsResponse, error)
+	// 取得響應式圖片的srcset
+	GetImageSrcset(context.Context, *SrcsetRequest) (*SrcsetResponse, error)
+	// 裁切、旋轉、調整圖片並產生新的衍生圖片
+	ProcessImage(context.Context, *ProcessImageRequest) (*ProcessImageResponse, error)
 	mustEmbedUnimplementedImageServiceServer()
 }
 
@@ -166,6 +390,54 @@ func (UnimplementedImageServiceServer) GetImageURI(context.Context, *ImageReques
 func (UnimplementedImageServiceServer) SyncImageCount(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncImageCount not implemented")
 }
+func (UnimplementedImageServiceServer) GetImageStats(context.Context, *ImageStatsRequest) (*ImageStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImageStats not implemented")
+}
+func (UnimplementedImageServiceServer) ListTopImages(context.Context, *ListTopImagesRequest) (*RankedImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopImages not implemented")
+}
+func (UnimplementedImageServiceServer) ListTrendingImages(context.Context, *ListTrendingImagesRequest) (*RankedImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrendingImages not implemented")
+}
+func (UnimplementedImageServiceServer) GetStorageUsage(context.Context, *StorageUsageRequest) (*StorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorageUsage not implemented")
+}
+func (UnimplementedImageServiceServer) AttachImage(context.Context, *ImageReferenceRequest) (*ImageReferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachImage not implemented")
+}
+func (UnimplementedImageServiceServer) DetachImage(context.Context, *ImageReferenceRequest) (*ImageReferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachImage not implemented")
+}
+func (UnimplementedImageServiceServer) ListImageUsages(context.Context, *ImageUsagesRequest) (*ImageUsagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImageUsages not implemented")
+}
+func (UnimplementedImageServiceServer) CollectUnusedImages(context.Context, *CollectUnusedImagesRequest) (*CollectUnusedImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectUnusedImages not implemented")
+}
+func (UnimplementedImageServiceServer) UpdateImage(context.Context, *UpdateImageRequest) (*UpdateImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateImage not implemented")
+}
+func (UnimplementedImageServiceServer) SearchImages(context.Context, *SearchImagesRequest) (*SearchImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchImages not implemented")
+}
+func (UnimplementedImageServiceServer) ReplaceImage(context.Context, *ReplaceImageRequest) (*ReplaceImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceImage not implemented")
+}
+func (UnimplementedImageServiceServer) CompleteReplaceImage(context.Context, *CompleteReplaceImageRequest) (*ImageVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteReplaceImage not implemented")
+}
+func (UnimplementedImageServiceServer) RollbackImage(context.Context, *RollbackImageRequest) (*ImageVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackImage not implemented")
+}
+func (UnimplementedImageServiceServer) ListImageVersions(context.Context, *ListImageVersionsRequest) (*ListImageVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImageVersions not implemented")
+}
+func (UnimplementedImageServiceServer) GetImageSrcset(context.Context, *SrcsetRequest) (*SrcsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImageSrcset not implemented")
+}
+func (UnimplementedImageServiceServer) ProcessImage(context.Context, *ProcessImageRequest) (*ProcessImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessImage not implemented")
+}
 func (UnimplementedImageServiceServer) mustEmbedUnimplementedImageServiceServer() {}
 
 // UnsafeImageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -305,6 +577,294 @@ func _ImageService_SyncImageCount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ImageService_GetImageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).GetImageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_GetImageStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).GetImageStats(ctx, req.(*ImageStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_ListTopImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ListTopImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_ListTopImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ListTopImages(ctx, req.(*ListTopImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_ListTrendingImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrendingImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ListTrendingImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_ListTrendingImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ListTrendingImages(ctx, req.(*ListTrendingImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_GetStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).GetStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_GetStorageUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).GetStorageUsage(ctx, req.(*StorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_AttachImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).AttachImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_AttachImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).AttachImage(ctx, req.(*ImageReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_DetachImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).DetachImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_DetachImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).DetachImage(ctx, req.(*ImageReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_ListImageUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageUsagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ListImageUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_ListImageUsages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ListImageUsages(ctx, req.(*ImageUsagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_CollectUnusedImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectUnusedImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).CollectUnusedImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_CollectUnusedImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).CollectUnusedImages(ctx, req.(*CollectUnusedImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_UpdateImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).UpdateImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_UpdateImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).UpdateImage(ctx, req.(*UpdateImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_SearchImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).SearchImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_SearchImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).SearchImages(ctx, req.(*SearchImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_ReplaceImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ReplaceImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_ReplaceImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ReplaceImage(ctx, req.(*ReplaceImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_CompleteReplaceImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteReplaceImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).CompleteReplaceImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_CompleteReplaceImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).CompleteReplaceImage(ctx, req.(*CompleteReplaceImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_RollbackImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).RollbackImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_RollbackImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).RollbackImage(ctx, req.(*RollbackImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_ListImageVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImageVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ListImageVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_ListImageVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ListImageVersions(ctx, req.(*ListImageVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_GetImageSrcset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SrcsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).GetImageSrcset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_GetImageSrcset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).GetImageSrcset(ctx, req.(*SrcsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ImageService_ProcessImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ImageServiceServer).ProcessImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ImageService_ProcessImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ImageServiceServer).ProcessImage(ctx, req.(*ProcessImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ImageService_ServiceDesc is the grpc.ServiceDesc for ImageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -340,6 +900,70 @@ var ImageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncImageCount",
 			Handler:    _ImageService_SyncImageCount_Handler,
 		},
+		{
+			MethodName: "GetImageStats",
+			Handler:    _ImageService_GetImageStats_Handler,
+		},
+		{
+			MethodName: "ListTopImages",
+			Handler:    _ImageService_ListTopImages_Handler,
+		},
+		{
+			MethodName: "ListTrendingImages",
+			Handler:    _ImageService_ListTrendingImages_Handler,
+		},
+		{
+			MethodName: "GetStorageUsage",
+			Handler:    _ImageService_GetStorageUsage_Handler,
+		},
+		{
+			MethodName: "AttachImage",
+			Handler:    _ImageService_AttachImage_Handler,
+		},
+		{
+			MethodName: "DetachImage",
+			Handler:    _ImageService_DetachImage_Handler,
+		},
+		{
+			MethodName: "ListImageUsages",
+			Handler:    _ImageService_ListImageUsages_Handler,
+		},
+		{
+			MethodName: "CollectUnusedImages",
+			Handler:    _ImageService_CollectUnusedImages_Handler,
+		},
+		{
+			MethodName: "UpdateImage",
+			Handler:    _ImageService_UpdateImage_Handler,
+		},
+		{
+			MethodName: "SearchImages",
+			Handler:    _ImageService_SearchImages_Handler,
+		},
+		{
+			MethodName: "ReplaceImage",
+			Handler:    _ImageService_ReplaceImage_Handler,
+		},
+		{
+			MethodName: "CompleteReplaceImage",
+			Handler:    _ImageService_CompleteReplaceImage_Handler,
+		},
+		{
+			MethodName: "RollbackImage",
+			Handler:    _ImageService_RollbackImage_Handler,
+		},
+		{
+			MethodName: "ListImageVersions",
+			Handler:    _ImageService_ListImageVersions_Handler,
+		},
+		{
+			MethodName: "GetImageSrcset",
+			Handler:    _ImageService_GetImageSrcset_Handler,
+		},
+		{
+			MethodName: "ProcessImage",
+			Handler:    _ImageService_ProcessImage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/image.proto",
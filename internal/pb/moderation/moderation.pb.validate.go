@@ -0,0 +1,847 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/moderation.proto
+
+package moderation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ModerationLabel with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ModerationLabel) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModerationLabel with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModerationLabelMultiError, or nil if none found.
+func (m *ModerationLabel) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModerationLabel) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Score
+
+	if len(errors) > 0 {
+		return ModerationLabelMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModerationLabelMultiError is an error wrapping multiple validation errors
+// returned by ModerationLabel.ValidateAll() if the designated constraints
+// aren't met.
+type ModerationLabelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModerationLabelMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModerationLabelMultiError) AllErrors() []error { return m }
+
+// ModerationLabelValidationError is the validation error returned by
+// ModerationLabel.Validate if the designated constraints aren't met.
+type ModerationLabelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModerationLabelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModerationLabelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModerationLabelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModerationLabelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModerationLabelValidationError) ErrorName() string { return "ModerationLabelValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModerationLabelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModerationLabel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModerationLabelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModerationLabelValidationError{}
+
+// Validate checks the field values on ModerationItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModerationItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModerationItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModerationItemMultiError,
+// or nil if none found.
+func (m *ModerationItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModerationItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ImageId
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Filename
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for ReportCount
+
+	// no validation rules for Hidden
+
+	// no validation rules for ClaimedBy
+
+	// no validation rules for ClaimedAt
+
+	// no validation rules for ModeratedBy
+
+	// no validation rules for ModeratedAt
+
+	// no validation rules for Uploaded
+
+	for idx, item := range m.GetLabels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ModerationItemValidationError{
+						field:  fmt.Sprintf("Labels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ModerationItemValidationError{
+						field:  fmt.Sprintf("Labels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ModerationItemValidationError{
+					field:  fmt.Sprintf("Labels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ModerationItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModerationItemMultiError is an error wrapping multiple validation errors
+// returned by ModerationItem.ValidateAll() if the designated constraints
+// aren't met.
+type ModerationItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModerationItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModerationItemMultiError) AllErrors() []error { return m }
+
+// ModerationItemValidationError is the validation error returned by
+// ModerationItem.Validate if the designated constraints aren't met.
+type ModerationItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModerationItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModerationItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModerationItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModerationItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModerationItemValidationError) ErrorName() string { return "ModerationItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModerationItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModerationItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModerationItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModerationItemValidationError{}
+
+// Validate checks the field values on ListModerationQueueRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModerationQueueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModerationQueueRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModerationQueueRequestMultiError, or nil if none found.
+func (m *ListModerationQueueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModerationQueueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStatus() != "" {
+
+		if _, ok := _ListModerationQueueRequest_Status_InLookup[m.GetStatus()]; !ok {
+			err := ListModerationQueueRequestValidationError{
+				field:  "Status",
+				reason: "value must be in list [pending held approved rejected takedown]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for UnclaimedOnly
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListModerationQueueRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := ListModerationQueueRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListModerationQueueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModerationQueueRequestMultiError is an error wrapping multiple
+// validation errors returned by ListModerationQueueRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListModerationQueueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModerationQueueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModerationQueueRequestMultiError) AllErrors() []error { return m }
+
+// ListModerationQueueRequestValidationError is the validation error returned
+// by ListModerationQueueRequest.Validate if the designated constraints aren't met.
+type ListModerationQueueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModerationQueueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModerationQueueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModerationQueueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModerationQueueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModerationQueueRequestValidationError) ErrorName() string {
+	return "ListModerationQueueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModerationQueueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModerationQueueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModerationQueueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModerationQueueRequestValidationError{}
+
+var _ListModerationQueueRequest_Status_InLookup = map[string]struct{}{
+	"pending":  {},
+	"held":     {},
+	"approved": {},
+	"rejected": {},
+	"takedown": {},
+}
+
+// Validate checks the field values on ListModerationQueueResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListModerationQueueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListModerationQueueResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListModerationQueueResponseMultiError, or nil if none found.
+func (m *ListModerationQueueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListModerationQueueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListModerationQueueResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListModerationQueueResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListModerationQueueResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListModerationQueueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListModerationQueueResponseMultiError is an error wrapping multiple
+// validation errors returned by ListModerationQueueResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListModerationQueueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListModerationQueueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListModerationQueueResponseMultiError) AllErrors() []error { return m }
+
+// ListModerationQueueResponseValidationError is the validation error returned
+// by ListModerationQueueResponse.Validate if the designated constraints
+// aren't met.
+type ListModerationQueueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListModerationQueueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListModerationQueueResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListModerationQueueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListModerationQueueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListModerationQueueResponseValidationError) ErrorName() string {
+	return "ListModerationQueueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListModerationQueueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListModerationQueueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListModerationQueueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListModerationQueueResponseValidationError{}
+
+// Validate checks the field values on ClaimModerationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClaimModerationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClaimModerationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClaimModerationRequestMultiError, or nil if none found.
+func (m *ClaimModerationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClaimModerationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := ClaimModerationRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ClaimModerationRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := ClaimModerationRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClaimModerationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClaimModerationRequestMultiError is an error wrapping multiple validation
+// errors returned by ClaimModerationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ClaimModerationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimModerationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimModerationRequestMultiError) AllErrors() []error { return m }
+
+// ClaimModerationRequestValidationError is the validation error returned by
+// ClaimModerationRequest.Validate if the designated constraints aren't met.
+type ClaimModerationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimModerationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimModerationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimModerationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimModerationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimModerationRequestValidationError) ErrorName() string {
+	return "ClaimModerationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClaimModerationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimModerationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimModerationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimModerationRequestValidationError{}
+
+var _ClaimModerationRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on ModerationDecisionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModerationDecisionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModerationDecisionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModerationDecisionRequestMultiError, or nil if none found.
+func (m *ModerationDecisionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModerationDecisionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := ModerationDecisionRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ModerationDecisionRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := ModerationDecisionRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 1000 {
+		err := ModerationDecisionRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ModerationDecisionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModerationDecisionRequestMultiError is an error wrapping multiple validation
+// errors returned by ModerationDecisionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ModerationDecisionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModerationDecisionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModerationDecisionRequestMultiError) AllErrors() []error { return m }
+
+// ModerationDecisionRequestValidationError is the validation error returned by
+// ModerationDecisionRequest.Validate if the designated constraints aren't met.
+type ModerationDecisionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModerationDecisionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModerationDecisionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModerationDecisionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModerationDecisionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModerationDecisionRequestValidationError) ErrorName() string {
+	return "ModerationDecisionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModerationDecisionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModerationDecisionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModerationDecisionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModerationDecisionRequestValidationError{}
+
+var _ModerationDecisionRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
@@ -0,0 +1,228 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/moderation.proto
+
+package moderation
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ModerationService_ListModerationQueue_FullMethodName = "/mediaService.ModerationService/ListModerationQueue"
+	ModerationService_ClaimModeration_FullMethodName     = "/mediaService.ModerationService/ClaimModeration"
+	ModerationService_ApproveImage_FullMethodName        = "/mediaService.ModerationService/ApproveImage"
+	ModerationService_RejectImage_FullMethodName         = "/mediaService.ModerationService/RejectImage"
+)
+
+// ModerationServiceClient is the client API for ModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ModerationServiceClient interface {
+	// 列出審核佇列
+	ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error)
+	// 認領待審核或被暫緩的圖片，認領期間其他管理員無法審核
+	ClaimModeration(ctx context.Context, in *ClaimModerationRequest, opts ...grpc.CallOption) (*ModerationItem, error)
+	// 核准圖片，因檢舉被隱藏的圖片會恢復顯示
+	ApproveImage(ctx context.Context, in *ModerationDecisionRequest, opts ...grpc.CallOption) (*ModerationItem, error)
+	// 駁回圖片，駁回後不再提供圖片
+	RejectImage(ctx context.Context, in *ModerationDecisionRequest, opts ...grpc.CallOption) (*ModerationItem, error)
+}
+
+type moderationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewModerationServiceClient(cc grpc.ClientConnInterface) ModerationServiceClient {
+	return &moderationServiceClient{cc}
+}
+
+func (c *moderationServiceClient) ListModerationQueue(ctx context.Context, in *ListModerationQueueRequest, opts ...grpc.CallOption) (*ListModerationQueueResponse, error) {
+	out := new(ListModerationQueueResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ListModerationQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ClaimModeration(ctx context.Context, in *ClaimModerationRequest, opts ...grpc.CallOption) (*ModerationItem, error) {
+	out := new(ModerationItem)
+	err := c.cc.Invoke(ctx, ModerationService_ClaimModeration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ApproveImage(ctx context.Context, in *ModerationDecisionRequest, opts ...grpc.CallOption) (*ModerationItem, error) {
+	out := new(ModerationItem)
+	err := c.cc.Invoke(ctx, ModerationService_ApproveImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) RejectImage(ctx context.Context, in *ModerationDecisionRequest, opts ...grpc.CallOption) (*ModerationItem, error) {
+	out := new(ModerationItem)
+	err := c.cc.Invoke(ctx, ModerationService_RejectImage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModerationServiceServer is the server API for ModerationService service.
+// All implementations must embed UnimplementedModerationServiceServer
+// for forward compatibility
+type ModerationServiceServer interface {
+	// 列出審核佇列
+	ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error)
+	// 認領待審核或被暫緩的圖片，認領期間其他管理員無法審核
+	ClaimModeration(context.Context, *ClaimModerationRequest) (*ModerationItem, error)
+	// 核准圖片，因檢舉被隱藏的圖片會恢復顯示
+	ApproveImage(context.Context, *ModerationDecisionRequest) (*ModerationItem, error)
+	// 駁回圖片，駁回後不再提供圖片
+	RejectImage(context.Context, *ModerationDecisionRequest) (*ModerationItem, error)
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+// UnimplementedModerationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedModerationServiceServer struct {
+}
+
+func (UnimplementedModerationServiceServer) ListModerationQueue(context.Context, *ListModerationQueueRequest) (*ListModerationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModerationQueue not implemented")
+}
+func (UnimplementedModerationServiceServer) ClaimModeration(context.Context, *ClaimModerationRequest) (*ModerationItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimModeration not implemented")
+}
+func (UnimplementedModerationServiceServer) ApproveImage(context.Context, *ModerationDecisionRequest) (*ModerationItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveImage not implemented")
+}
+func (UnimplementedModerationServiceServer) RejectImage(context.Context, *ModerationDecisionRequest) (*ModerationItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectImage not implemented")
+}
+func (UnimplementedModerationServiceServer) mustEmbedUnimplementedModerationServiceServer() {}
+
+// UnsafeModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ModerationServiceServer will
+// result in compilation errors.
+type UnsafeModerationServiceServer interface {
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+func RegisterModerationServiceServer(s grpc.ServiceRegistrar, srv ModerationServiceServer) {
+	s.RegisterService(&ModerationService_ServiceDesc, srv)
+}
+
+func _ModerationService_ListModerationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModerationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListModerationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListModerationQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListModerationQueue(ctx, req.(*ListModerationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ClaimModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimModerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ClaimModeration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ClaimModeration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ClaimModeration(ctx, req.(*ClaimModerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ApproveImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerationDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ApproveImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ApproveImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ApproveImage(ctx, req.(*ModerationDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_RejectImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerationDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).RejectImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_RejectImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).RejectImage(ctx, req.(*ModerationDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ModerationService_ServiceDesc is the grpc.ServiceDesc for ModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mediaService.ModerationService",
+	HandlerType: (*ModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListModerationQueue",
+			Handler:    _ModerationService_ListModerationQueue_Handler,
+		},
+		{
+			MethodName: "ClaimModeration",
+			Handler:    _ModerationService_ClaimModeration_Handler,
+		},
+		{
+			MethodName: "ApproveImage",
+			Handler:    _ModerationService_ApproveImage_Handler,
+		},
+		{
+			MethodName: "RejectImage",
+			Handler:    _ModerationService_RejectImage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/moderation.proto",
+}
@@ -0,0 +1,853 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/report.proto
+
+package report
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Report) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Report with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ReportMultiError, or nil if none found.
+func (m *Report) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Report) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReportId
+
+	// no validation rules for ImageId
+
+	// no validation rules for ReporterId
+
+	// no validation rules for Reason
+
+	// no validation rules for Detail
+
+	// no validation rules for Status
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return ReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportMultiError is an error wrapping multiple validation errors returned by
+// Report.ValidateAll() if the designated constraints aren't met.
+type ReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportMultiError) AllErrors() []error { return m }
+
+// ReportValidationError is the validation error returned by Report.Validate if
+// the designated constraints aren't met.
+type ReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportValidationError) ErrorName() string { return "ReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportValidationError{}
+
+// Validate checks the field values on ReportImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportImageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportImageRequestMultiError, or nil if none found.
+func (m *ReportImageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportImageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetImageId()) < 1 {
+		err := ReportImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_ReportImageRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+		err := ReportImageRequestValidationError{
+			field:  "ImageId",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ReportImageRequest_Reason_NotInLookup[m.GetReason()]; ok {
+		err := ReportImageRequestValidationError{
+			field:  "Reason",
+			reason: "value must not be in list [REASON_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ReportReason_name[int32(m.GetReason())]; !ok {
+		err := ReportImageRequestValidationError{
+			field:  "Reason",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDetail()) > 1000 {
+		err := ReportImageRequestValidationError{
+			field:  "Detail",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReportImageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportImageRequestMultiError is an error wrapping multiple validation errors
+// returned by ReportImageRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReportImageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportImageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportImageRequestMultiError) AllErrors() []error { return m }
+
+// ReportImageRequestValidationError is the validation error returned by
+// ReportImageRequest.Validate if the designated constraints aren't met.
+type ReportImageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportImageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportImageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportImageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportImageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportImageRequestValidationError) ErrorName() string {
+	return "ReportImageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportImageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportImageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportImageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportImageRequestValidationError{}
+
+var _ReportImageRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+var _ReportImageRequest_Reason_NotInLookup = map[ReportReason]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportsRequestMultiError, or nil if none found.
+func (m *ListReportsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetImageId() != "" {
+
+		if !_ListReportsRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+			err := ListReportsRequestValidationError{
+				field:  "ImageId",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetStatus() != "" {
+
+		if _, ok := _ListReportsRequest_Status_InLookup[m.GetStatus()]; !ok {
+			err := ListReportsRequestValidationError{
+				field:  "Status",
+				reason: "value must be in list [open resolved dismissed]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := ReportReason_name[int32(m.GetReason())]; !ok {
+		err := ListReportsRequestValidationError{
+			field:  "Reason",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListReportsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := ListReportsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListReportsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListReportsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListReportsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportsRequestMultiError) AllErrors() []error { return m }
+
+// ListReportsRequestValidationError is the validation error returned by
+// ListReportsRequest.Validate if the designated constraints aren't met.
+type ListReportsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportsRequestValidationError) ErrorName() string {
+	return "ListReportsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportsRequestValidationError{}
+
+var _ListReportsRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+var _ListReportsRequest_Status_InLookup = map[string]struct{}{
+	"open":      {},
+	"resolved":  {},
+	"dismissed": {},
+}
+
+// Validate checks the field values on ListReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListReportsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListReportsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListReportsResponseMultiError, or nil if none found.
+func (m *ListReportsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListReportsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReports() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListReportsResponseValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListReportsResponseValidationError{
+						field:  fmt.Sprintf("Reports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListReportsResponseValidationError{
+					field:  fmt.Sprintf("Reports[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListReportsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListReportsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListReportsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListReportsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListReportsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListReportsResponseMultiError) AllErrors() []error { return m }
+
+// ListReportsResponseValidationError is the validation error returned by
+// ListReportsResponse.Validate if the designated constraints aren't met.
+type ListReportsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListReportsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListReportsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListReportsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListReportsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListReportsResponseValidationError) ErrorName() string {
+	return "ListReportsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListReportsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListReportsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListReportsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListReportsResponseValidationError{}
+
+// Validate checks the field values on ReportStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportStatsRequestMultiError, or nil if none found.
+func (m *ReportStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetImageId() != "" {
+
+		if !_ReportStatsRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+			err := ReportStatsRequestValidationError{
+				field:  "ImageId",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReportStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportStatsRequestMultiError is an error wrapping multiple validation errors
+// returned by ReportStatsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReportStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportStatsRequestMultiError) AllErrors() []error { return m }
+
+// ReportStatsRequestValidationError is the validation error returned by
+// ReportStatsRequest.Validate if the designated constraints aren't met.
+type ReportStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportStatsRequestValidationError) ErrorName() string {
+	return "ReportStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportStatsRequestValidationError{}
+
+var _ReportStatsRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on ReportStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReportStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReportStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReportStatsResponseMultiError, or nil if none found.
+func (m *ReportStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReportStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Open
+
+	// no validation rules for ByReason
+
+	// no validation rules for ByStatus
+
+	// no validation rules for HiddenImages
+
+	if len(errors) > 0 {
+		return ReportStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReportStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by ReportStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReportStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReportStatsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReportStatsResponseMultiError) AllErrors() []error { return m }
+
+// ReportStatsResponseValidationError is the validation error returned by
+// ReportStatsResponse.Validate if the designated constraints aren't met.
+type ReportStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReportStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReportStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReportStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReportStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReportStatsResponseValidationError) ErrorName() string {
+	return "ReportStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReportStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReportStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReportStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReportStatsResponseValidationError{}
@@ -0,0 +1,1472 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/takedown.proto
+
+package takedown
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Claimant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Claimant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Claimant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClaimantMultiError, or nil
+// if none found.
+func (m *Claimant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Claimant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 200 {
+		err := ClaimantValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = ClaimantValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOrganization()) > 200 {
+		err := ClaimantValidationError{
+			field:  "Organization",
+			reason: "value length must be at most 200 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClaimantMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Claimant) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Claimant) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// ClaimantMultiError is an error wrapping multiple validation errors returned
+// by Claimant.ValidateAll() if the designated constraints aren't met.
+type ClaimantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClaimantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClaimantMultiError) AllErrors() []error { return m }
+
+// ClaimantValidationError is the validation error returned by
+// Claimant.Validate if the designated constraints aren't met.
+type ClaimantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClaimantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClaimantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClaimantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClaimantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClaimantValidationError) ErrorName() string { return "ClaimantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClaimantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClaimant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClaimantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClaimantValidationError{}
+
+// Validate checks the field values on CounterNotice with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CounterNotice) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CounterNotice with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CounterNoticeMultiError, or
+// nil if none found.
+func (m *CounterNotice) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CounterNotice) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OwnerId
+
+	// no validation rules for Statement
+
+	// no validation rules for ContactName
+
+	// no validation rules for ContactEmail
+
+	// no validation rules for FiledAt
+
+	if len(errors) > 0 {
+		return CounterNoticeMultiError(errors)
+	}
+
+	return nil
+}
+
+// CounterNoticeMultiError is an error wrapping multiple validation errors
+// returned by CounterNotice.ValidateAll() if the designated constraints
+// aren't met.
+type CounterNoticeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CounterNoticeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CounterNoticeMultiError) AllErrors() []error { return m }
+
+// CounterNoticeValidationError is the validation error returned by
+// CounterNotice.Validate if the designated constraints aren't met.
+type CounterNoticeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CounterNoticeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CounterNoticeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CounterNoticeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CounterNoticeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CounterNoticeValidationError) ErrorName() string { return "CounterNoticeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CounterNoticeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCounterNotice.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CounterNoticeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CounterNoticeValidationError{}
+
+// Validate checks the field values on Takedown with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Takedown) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Takedown with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TakedownMultiError, or nil
+// if none found.
+func (m *Takedown) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Takedown) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TakedownId
+
+	if all {
+		switch v := interface{}(m.GetClaimant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TakedownValidationError{
+					field:  "Claimant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TakedownValidationError{
+					field:  "Claimant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaimant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TakedownValidationError{
+				field:  "Claimant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NoticeText
+
+	// no validation rules for Status
+
+	// no validation rules for FiledBy
+
+	// no validation rules for ActionedBy
+
+	// no validation rules for ActionedAt
+
+	for idx, item := range m.GetCounterNotices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TakedownValidationError{
+						field:  fmt.Sprintf("CounterNotices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TakedownValidationError{
+						field:  fmt.Sprintf("CounterNotices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TakedownValidationError{
+					field:  fmt.Sprintf("CounterNotices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return TakedownMultiError(errors)
+	}
+
+	return nil
+}
+
+// TakedownMultiError is an error wrapping multiple validation errors returned
+// by Takedown.ValidateAll() if the designated constraints aren't met.
+type TakedownMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TakedownMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TakedownMultiError) AllErrors() []error { return m }
+
+// TakedownValidationError is the validation error returned by
+// Takedown.Validate if the designated constraints aren't met.
+type TakedownValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TakedownValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TakedownValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TakedownValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TakedownValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TakedownValidationError) ErrorName() string { return "TakedownValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TakedownValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTakedown.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TakedownValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TakedownValidationError{}
+
+// Validate checks the field values on FileTakedownRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FileTakedownRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FileTakedownRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FileTakedownRequestMultiError, or nil if none found.
+func (m *FileTakedownRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FileTakedownRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetClaimant() == nil {
+		err := FileTakedownRequestValidationError{
+			field:  "Claimant",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetClaimant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FileTakedownRequestValidationError{
+					field:  "Claimant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FileTakedownRequestValidationError{
+					field:  "Claimant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClaimant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FileTakedownRequestValidationError{
+				field:  "Claimant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetNoticeText()); l < 1 || l > 20000 {
+		err := FileTakedownRequestValidationError{
+			field:  "NoticeText",
+			reason: "value length must be between 1 and 20000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetImageIds()); l < 1 || l > 100 {
+		err := FileTakedownRequestValidationError{
+			field:  "ImageIds",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_FileTakedownRequest_ImageIds_Unique := make(map[string]struct{}, len(m.GetImageIds()))
+
+	for idx, item := range m.GetImageIds() {
+		_, _ = idx, item
+
+		if _, exists := _FileTakedownRequest_ImageIds_Unique[item]; exists {
+			err := FileTakedownRequestValidationError{
+				field:  fmt.Sprintf("ImageIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_FileTakedownRequest_ImageIds_Unique[item] = struct{}{}
+		}
+
+		if !_FileTakedownRequest_ImageIds_Pattern.MatchString(item) {
+			err := FileTakedownRequestValidationError{
+				field:  fmt.Sprintf("ImageIds[%v]", idx),
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FileTakedownRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FileTakedownRequestMultiError is an error wrapping multiple validation
+// errors returned by FileTakedownRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FileTakedownRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FileTakedownRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FileTakedownRequestMultiError) AllErrors() []error { return m }
+
+// FileTakedownRequestValidationError is the validation error returned by
+// FileTakedownRequest.Validate if the designated constraints aren't met.
+type FileTakedownRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FileTakedownRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FileTakedownRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FileTakedownRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FileTakedownRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FileTakedownRequestValidationError) ErrorName() string {
+	return "FileTakedownRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FileTakedownRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFileTakedownRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FileTakedownRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FileTakedownRequestValidationError{}
+
+var _FileTakedownRequest_ImageIds_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on ActionTakedownRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ActionTakedownRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ActionTakedownRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ActionTakedownRequestMultiError, or nil if none found.
+func (m *ActionTakedownRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ActionTakedownRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ActionTakedownRequest_TakedownId_Pattern.MatchString(m.GetTakedownId()) {
+		err := ActionTakedownRequestValidationError{
+			field:  "TakedownId",
+			reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ActionTakedownRequest_Action_InLookup[m.GetAction()]; !ok {
+		err := ActionTakedownRequestValidationError{
+			field:  "Action",
+			reason: "value must be in list [takedown dismiss restore]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNote()) > 1000 {
+		err := ActionTakedownRequestValidationError{
+			field:  "Note",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ActionTakedownRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ActionTakedownRequestMultiError is an error wrapping multiple validation
+// errors returned by ActionTakedownRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ActionTakedownRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ActionTakedownRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ActionTakedownRequestMultiError) AllErrors() []error { return m }
+
+// ActionTakedownRequestValidationError is the validation error returned by
+// ActionTakedownRequest.Validate if the designated constraints aren't met.
+type ActionTakedownRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ActionTakedownRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ActionTakedownRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ActionTakedownRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ActionTakedownRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ActionTakedownRequestValidationError) ErrorName() string {
+	return "ActionTakedownRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ActionTakedownRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sActionTakedownRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ActionTakedownRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ActionTakedownRequestValidationError{}
+
+var _ActionTakedownRequest_TakedownId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+var _ActionTakedownRequest_Action_InLookup = map[string]struct{}{
+	"takedown": {},
+	"dismiss":  {},
+	"restore":  {},
+}
+
+// Validate checks the field values on CounterNoticeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CounterNoticeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CounterNoticeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CounterNoticeRequestMultiError, or nil if none found.
+func (m *CounterNoticeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CounterNoticeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_CounterNoticeRequest_TakedownId_Pattern.MatchString(m.GetTakedownId()) {
+		err := CounterNoticeRequestValidationError{
+			field:  "TakedownId",
+			reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetStatement()); l < 1 || l > 20000 {
+		err := CounterNoticeRequestValidationError{
+			field:  "Statement",
+			reason: "value length must be between 1 and 20000 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetContactName()); l < 1 || l > 200 {
+		err := CounterNoticeRequestValidationError{
+			field:  "ContactName",
+			reason: "value length must be between 1 and 200 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetContactEmail()); err != nil {
+		err = CounterNoticeRequestValidationError{
+			field:  "ContactEmail",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetConsent() != true {
+		err := CounterNoticeRequestValidationError{
+			field:  "Consent",
+			reason: "value must equal true",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CounterNoticeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CounterNoticeRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *CounterNoticeRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// CounterNoticeRequestMultiError is an error wrapping multiple validation
+// errors returned by CounterNoticeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CounterNoticeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CounterNoticeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CounterNoticeRequestMultiError) AllErrors() []error { return m }
+
+// CounterNoticeRequestValidationError is the validation error returned by
+// CounterNoticeRequest.Validate if the designated constraints aren't met.
+type CounterNoticeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CounterNoticeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CounterNoticeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CounterNoticeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CounterNoticeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CounterNoticeRequestValidationError) ErrorName() string {
+	return "CounterNoticeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CounterNoticeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCounterNoticeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CounterNoticeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CounterNoticeRequestValidationError{}
+
+var _CounterNoticeRequest_TakedownId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+// Validate checks the field values on GetTakedownRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTakedownRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTakedownRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTakedownRequestMultiError, or nil if none found.
+func (m *GetTakedownRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTakedownRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetTakedownRequest_TakedownId_Pattern.MatchString(m.GetTakedownId()) {
+		err := GetTakedownRequestValidationError{
+			field:  "TakedownId",
+			reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetTakedownRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTakedownRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTakedownRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTakedownRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTakedownRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTakedownRequestMultiError) AllErrors() []error { return m }
+
+// GetTakedownRequestValidationError is the validation error returned by
+// GetTakedownRequest.Validate if the designated constraints aren't met.
+type GetTakedownRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTakedownRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTakedownRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTakedownRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTakedownRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTakedownRequestValidationError) ErrorName() string {
+	return "GetTakedownRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTakedownRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTakedownRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTakedownRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTakedownRequestValidationError{}
+
+var _GetTakedownRequest_TakedownId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+// Validate checks the field values on ListTakedownsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTakedownsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTakedownsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTakedownsRequestMultiError, or nil if none found.
+func (m *ListTakedownsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTakedownsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStatus() != "" {
+
+		if _, ok := _ListTakedownsRequest_Status_InLookup[m.GetStatus()]; !ok {
+			err := ListTakedownsRequestValidationError{
+				field:  "Status",
+				reason: "value must be in list [received actioned dismissed countered restored]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetImageId() != "" {
+
+		if !_ListTakedownsRequest_ImageId_Pattern.MatchString(m.GetImageId()) {
+			err := ListTakedownsRequestValidationError{
+				field:  "ImageId",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListTakedownsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOffset() < 0 {
+		err := ListTakedownsRequestValidationError{
+			field:  "Offset",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListTakedownsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTakedownsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTakedownsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTakedownsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTakedownsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTakedownsRequestMultiError) AllErrors() []error { return m }
+
+// ListTakedownsRequestValidationError is the validation error returned by
+// ListTakedownsRequest.Validate if the designated constraints aren't met.
+type ListTakedownsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTakedownsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTakedownsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTakedownsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTakedownsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTakedownsRequestValidationError) ErrorName() string {
+	return "ListTakedownsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTakedownsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTakedownsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTakedownsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTakedownsRequestValidationError{}
+
+var _ListTakedownsRequest_Status_InLookup = map[string]struct{}{
+	"received":  {},
+	"actioned":  {},
+	"dismissed": {},
+	"countered": {},
+	"restored":  {},
+}
+
+var _ListTakedownsRequest_ImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+// Validate checks the field values on ListTakedownsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTakedownsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTakedownsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTakedownsResponseMultiError, or nil if none found.
+func (m *ListTakedownsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTakedownsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTakedowns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTakedownsResponseValidationError{
+						field:  fmt.Sprintf("Takedowns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTakedownsResponseValidationError{
+						field:  fmt.Sprintf("Takedowns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTakedownsResponseValidationError{
+					field:  fmt.Sprintf("Takedowns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTakedownsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTakedownsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTakedownsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTakedownsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTakedownsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTakedownsResponseMultiError) AllErrors() []error { return m }
+
+// ListTakedownsResponseValidationError is the validation error returned by
+// ListTakedownsResponse.Validate if the designated constraints aren't met.
+type ListTakedownsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTakedownsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTakedownsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTakedownsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTakedownsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTakedownsResponseValidationError) ErrorName() string {
+	return "ListTakedownsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTakedownsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTakedownsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTakedownsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTakedownsResponseValidationError{}
@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/takedown.proto
+
+package takedown
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TakedownService_FileTakedown_FullMethodName      = "/mediaService.TakedownService/FileTakedown"
+	TakedownService_ActionTakedown_FullMethodName    = "/mediaService.TakedownService/ActionTakedown"
+	TakedownService_GetTakedown_FullMethodName       = "/mediaService.TakedownService/GetTakedown"
+	TakedownService_ListTakedowns_FullMethodName     = "/mediaService.TakedownService/ListTakedowns"
+	TakedownService_FileCounterNotice_FullMethodName = "/mediaService.TakedownService/FileCounterNotice"
+)
+
+// TakedownServiceClient is the client API for TakedownService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TakedownServiceClient interface {
+	// 登錄收到的下架通知，僅限管理員使用
+	FileTakedown(ctx context.Context, in *FileTakedownRequest, opts ...grpc.CallOption) (*Takedown, error)
+	// 處理下架通知，僅限管理員使用
+	ActionTakedown(ctx context.Context, in *ActionTakedownRequest, opts ...grpc.CallOption) (*Takedown, error)
+	// 查詢下架通知，僅限管理員使用
+	GetTakedown(ctx context.Context, in *GetTakedownRequest, opts ...grpc.CallOption) (*Takedown, error)
+	// 列出下架通知，僅限管理員使用
+	ListTakedowns(ctx context.Context, in *ListTakedownsRequest, opts ...grpc.CallOption) (*ListTakedownsResponse, error)
+	// 圖片擁有者對已下架的圖片提出反通知
+	FileCounterNotice(ctx context.Context, in *CounterNoticeRequest, opts ...grpc.CallOption) (*Takedown, error)
+}
+
+type takedownServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTakedownServiceClient(cc grpc.ClientConnInterface) TakedownServiceClient {
+	return &takedownServiceClient{cc}
+}
+
+func (c *takedownServiceClient) FileTakedown(ctx context.Context, in *FileTakedownRequest, opts ...grpc.CallOption) (*Takedown, error) {
+	out := new(Takedown)
+	err := c.cc.Invoke(ctx, TakedownService_FileTakedown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *takedownServiceClient) ActionTakedown(ctx context.Context, in *ActionTakedownRequest, opts ...grpc.CallOption) (*Takedown, error) {
+	out := new(Takedown)
+	err := c.cc.Invoke(ctx, TakedownService_ActionTakedown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *takedownServiceClient) GetTakedown(ctx context.Context, in *GetTakedownRequest, opts ...grpc.CallOption) (*Takedown, error) {
+	out := new(Takedown)
+	err := c.cc.Invoke(ctx, TakedownService_GetTakedown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *takedownServiceClient) ListTakedowns(ctx context.Context, in *ListTakedownsRequest, opts ...grpc.CallOption) (*ListTakedownsResponse, error) {
+	out := new(ListTakedownsResponse)
+	err := c.cc.Invoke(ctx, TakedownService_ListTakedowns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *takedownServiceClient) FileCounterNotice(ctx context.Context, in *CounterNoticeRequest, opts ...grpc.CallOption) (*Takedown, error) {
+	out := new(Takedown)
+	err := c.cc.Invoke(ctx, TakedownService_FileCounterNotice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TakedownServiceServer is the server API for TakedownService service.
+// All implementations must embed UnimplementedTakedownServiceServer
+// for forward compatibility
+type TakedownServiceServer interface {
+	// 登錄收到的下架通知，僅限管理員使用
+	FileTakedown(context.Context, *FileTakedownRequest) (*Takedown, error)
+	// 處理下架通知，僅限管理員使用
+	ActionTakedown(context.Context, *ActionTakedownRequest) (*Takedown, error)
+	// 查詢下架通知，僅限管理員使用
+	GetTakedown(context.Context, *GetTakedownRequest) (*Takedown, error)
+	// 列出下架通知，僅限管理員使用
+	ListTakedowns(context.Context, *ListTakedownsRequest) (*ListTakedownsResponse, error)
+	// 圖片擁有者對已下架的圖片提出反通知
+	FileCounterNotice(context.Context, *CounterNoticeRequest) (*Takedown, error)
+	mustEmbedUnimplementedTakedownServiceServer()
+}
+
+// UnimplementedTakedownServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTakedownServiceServer struct {
+}
+
+func (UnimplementedTakedownServiceServer) FileTakedown(context.Context, *FileTakedownRequest) (*Takedown, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileTakedown not implemented")
+}
+func (UnimplementedTakedownServiceServer) ActionTakedown(context.Context, *ActionTakedownRequest) (*Takedown, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionTakedown not implemented")
+}
+func (UnimplementedTakedownServiceServer) GetTakedown(context.Context, *GetTakedownRequest) (*Takedown, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTakedown not implemented")
+}
+func (UnimplementedTakedownServiceServer) ListTakedowns(context.Context, *ListTakedownsRequest) (*ListTakedownsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTakedowns not implemented")
+}
+func (UnimplementedTakedownServiceServer) FileCounterNotice(context.Context, *CounterNoticeRequest) (*Takedown, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FileCounterNotice not implemented")
+}
+func (UnimplementedTakedownServiceServer) mustEmbedUnimplementedTakedownServiceServer() {}
+
+// UnsafeTakedownServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TakedownServiceServer will
+// result in compilation errors.
+type UnsafeTakedownServiceServer interface {
+	mustEmbedUnimplementedTakedownServiceServer()
+}
+
+func RegisterTakedownServiceServer(s grpc.ServiceRegistrar, srv TakedownServiceServer) {
+	s.RegisterService(&TakedownService_ServiceDesc, srv)
+}
+
+func _TakedownService_FileTakedown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileTakedownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TakedownServiceServer).FileTakedown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TakedownService_FileTakedown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TakedownServiceServer).FileTakedown(ctx, req.(*FileTakedownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TakedownService_ActionTakedown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActionTakedownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TakedownServiceServer).ActionTakedown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TakedownService_ActionTakedown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TakedownServiceServer).ActionTakedown(ctx, req.(*ActionTakedownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TakedownService_GetTakedown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTakedownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TakedownServiceServer).GetTakedown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TakedownService_GetTakedown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TakedownServiceServer).GetTakedown(ctx, req.(*GetTakedownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TakedownService_ListTakedowns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTakedownsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TakedownServiceServer).ListTakedowns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TakedownService_ListTakedowns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TakedownServiceServer).ListTakedowns(ctx, req.(*ListTakedownsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TakedownService_FileCounterNotice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterNoticeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TakedownServiceServer).FileCounterNotice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TakedownService_FileCounterNotice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TakedownServiceServer).FileCounterNotice(ctx, req.(*CounterNoticeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TakedownService_ServiceDesc is the grpc.ServiceDesc for TakedownService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TakedownService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mediaService.TakedownService",
+	HandlerType: (*TakedownServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FileTakedown",
+			Handler:    _TakedownService_FileTakedown_Handler,
+		},
+		{
+			MethodName: "ActionTakedown",
+			Handler:    _TakedownService_ActionTakedown_Handler,
+		},
+		{
+			MethodName: "GetTakedown",
+			Handler:    _TakedownService_GetTakedown_Handler,
+		},
+		{
+			MethodName: "ListTakedowns",
+			Handler:    _TakedownService_ListTakedowns_Handler,
+		},
+		{
+			MethodName: "FileCounterNotice",
+			Handler:    _TakedownService_FileCounterNotice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/takedown.proto",
+}
@@ -0,0 +1,984 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/variant.proto
+
+package variant
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Variant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Variant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Variant with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in VariantMultiError, or nil if none found.
+func (m *Variant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Variant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for Fit
+
+	// no validation rules for Metadata
+
+	// no validation rules for NeverRequireSignedUrls
+
+	// no validation rules for CreatedAt
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return VariantMultiError(errors)
+	}
+
+	return nil
+}
+
+// VariantMultiError is an error wrapping multiple validation errors returned
+// by Variant.ValidateAll() if the designated constraints aren't met.
+type VariantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VariantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VariantMultiError) AllErrors() []error { return m }
+
+// VariantValidationError is the validation error returned by Variant.Validate
+// if the designated constraints aren't met.
+type VariantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VariantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VariantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VariantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VariantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VariantValidationError) ErrorName() string { return "VariantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VariantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVariant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VariantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VariantValidationError{}
+
+// Validate checks the field values on VariantDefinitionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VariantDefinitionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VariantDefinitionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VariantDefinitionRequestMultiError, or nil if none found.
+func (m *VariantDefinitionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VariantDefinitionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_VariantDefinitionRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := VariantDefinitionRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{1,99}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetWidth(); val <= 0 || val > 12000 {
+		err := VariantDefinitionRequestValidationError{
+			field:  "Width",
+			reason: "value must be inside range (0, 12000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetHeight(); val <= 0 || val > 12000 {
+		err := VariantDefinitionRequestValidationError{
+			field:  "Height",
+			reason: "value must be inside range (0, 12000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _VariantDefinitionRequest_Fit_InLookup[m.GetFit()]; !ok {
+		err := VariantDefinitionRequestValidationError{
+			field:  "Fit",
+			reason: "value must be in list [scale-down contain cover crop pad]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _VariantDefinitionRequest_Metadata_InLookup[m.GetMetadata()]; !ok {
+		err := VariantDefinitionRequestValidationError{
+			field:  "Metadata",
+			reason: "value must be in list [keep copyright none]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for NeverRequireSignedUrls
+
+	if len(errors) > 0 {
+		return VariantDefinitionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VariantDefinitionRequestMultiError is an error wrapping multiple validation
+// errors returned by VariantDefinitionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VariantDefinitionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VariantDefinitionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VariantDefinitionRequestMultiError) AllErrors() []error { return m }
+
+// VariantDefinitionRequestValidationError is the validation error returned by
+// VariantDefinitionRequest.Validate if the designated constraints aren't met.
+type VariantDefinitionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VariantDefinitionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VariantDefinitionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VariantDefinitionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VariantDefinitionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VariantDefinitionRequestValidationError) ErrorName() string {
+	return "VariantDefinitionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VariantDefinitionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVariantDefinitionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VariantDefinitionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VariantDefinitionRequestValidationError{}
+
+var _VariantDefinitionRequest_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{1,99}$")
+
+var _VariantDefinitionRequest_Fit_InLookup = map[string]struct{}{
+	"scale-down": {},
+	"contain":    {},
+	"cover":      {},
+	"crop":       {},
+	"pad":        {},
+}
+
+var _VariantDefinitionRequest_Metadata_InLookup = map[string]struct{}{
+	"keep":      {},
+	"copyright": {},
+	"none":      {},
+}
+
+// Validate checks the field values on VariantRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VariantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VariantRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VariantRequestMultiError,
+// or nil if none found.
+func (m *VariantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VariantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_VariantRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := VariantRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-zA-Z0-9]{1,99}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VariantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VariantRequestMultiError is an error wrapping multiple validation errors
+// returned by VariantRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VariantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VariantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VariantRequestMultiError) AllErrors() []error { return m }
+
+// VariantRequestValidationError is the validation error returned by
+// VariantRequest.Validate if the designated constraints aren't met.
+type VariantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VariantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VariantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VariantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VariantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VariantRequestValidationError) ErrorName() string { return "VariantRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VariantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVariantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VariantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VariantRequestValidationError{}
+
+var _VariantRequest_Name_Pattern = regexp.MustCompile("^[a-zA-Z0-9]{1,99}$")
+
+// Validate checks the field values on ListVariantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVariantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVariantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVariantsRequestMultiError, or nil if none found.
+func (m *ListVariantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVariantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListVariantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListVariantsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListVariantsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListVariantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVariantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVariantsRequestMultiError) AllErrors() []error { return m }
+
+// ListVariantsRequestValidationError is the validation error returned by
+// ListVariantsRequest.Validate if the designated constraints aren't met.
+type ListVariantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVariantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVariantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVariantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVariantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVariantsRequestValidationError) ErrorName() string {
+	return "ListVariantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVariantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVariantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVariantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVariantsRequestValidationError{}
+
+// Validate checks the field values on ListVariantsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListVariantsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListVariantsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListVariantsResponseMultiError, or nil if none found.
+func (m *ListVariantsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListVariantsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVariants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListVariantsResponseValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListVariantsResponseValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListVariantsResponseValidationError{
+					field:  fmt.Sprintf("Variants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListVariantsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListVariantsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListVariantsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListVariantsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListVariantsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListVariantsResponseMultiError) AllErrors() []error { return m }
+
+// ListVariantsResponseValidationError is the validation error returned by
+// ListVariantsResponse.Validate if the designated constraints aren't met.
+type ListVariantsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListVariantsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListVariantsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListVariantsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListVariantsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListVariantsResponseValidationError) ErrorName() string {
+	return "ListVariantsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListVariantsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListVariantsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListVariantsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListVariantsResponseValidationError{}
+
+// Validate checks the field values on DeleteVariantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteVariantResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteVariantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteVariantResponseMultiError, or nil if none found.
+func (m *DeleteVariantResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteVariantResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteVariantResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteVariantResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteVariantResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteVariantResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteVariantResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteVariantResponseMultiError) AllErrors() []error { return m }
+
+// DeleteVariantResponseValidationError is the validation error returned by
+// DeleteVariantResponse.Validate if the designated constraints aren't met.
+type DeleteVariantResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteVariantResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteVariantResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteVariantResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteVariantResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteVariantResponseValidationError) ErrorName() string {
+	return "DeleteVariantResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteVariantResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteVariantResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteVariantResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteVariantResponseValidationError{}
+
+// Validate checks the field values on SyncVariantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncVariantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncVariantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncVariantsRequestMultiError, or nil if none found.
+func (m *SyncVariantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncVariantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SyncVariantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncVariantsRequestMultiError is an error wrapping multiple validation
+// errors returned by SyncVariantsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SyncVariantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncVariantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncVariantsRequestMultiError) AllErrors() []error { return m }
+
+// SyncVariantsRequestValidationError is the validation error returned by
+// SyncVariantsRequest.Validate if the designated constraints aren't met.
+type SyncVariantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncVariantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncVariantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncVariantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncVariantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncVariantsRequestValidationError) ErrorName() string {
+	return "SyncVariantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncVariantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncVariantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncVariantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncVariantsRequestValidationError{}
+
+// Validate checks the field values on SyncVariantsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SyncVariantsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncVariantsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SyncVariantsResponseMultiError, or nil if none found.
+func (m *SyncVariantsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncVariantsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SyncVariantsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncVariantsResponseMultiError is an error wrapping multiple validation
+// errors returned by SyncVariantsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SyncVariantsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncVariantsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncVariantsResponseMultiError) AllErrors() []error { return m }
+
+// SyncVariantsResponseValidationError is the validation error returned by
+// SyncVariantsResponse.Validate if the designated constraints aren't met.
+type SyncVariantsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncVariantsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncVariantsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncVariantsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncVariantsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncVariantsResponseValidationError) ErrorName() string {
+	return "SyncVariantsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SyncVariantsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncVariantsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncVariantsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncVariantsResponseValidationError{}
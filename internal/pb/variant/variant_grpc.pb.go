@@ -0,0 +1,267 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/variant.proto
+
+package variant
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	VariantService_CreateVariant_FullMethodName = "/mediaService.VariantService/CreateVariant"
+	VariantService_UpdateVariant_FullMethodName = "/mediaService.VariantService/UpdateVariant"
+	VariantService_ListVariants_FullMethodName  = "/mediaService.VariantService/ListVariants"
+	VariantService_SyncVariants_FullMethodName  = "/mediaService.VariantService/SyncVariants"
+	VariantService_DeleteVariant_FullMethodName = "/mediaService.VariantService/DeleteVariant"
+)
+
+// VariantServiceClient is the client API for VariantService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VariantServiceClient interface {
+	// 建立變體
+	CreateVariant(ctx context.Context, in *VariantDefinitionRequest, opts ...grpc.CallOption) (*Variant, error)
+	// 更新變體的尺寸與處理方式
+	UpdateVariant(ctx context.Context, in *VariantDefinitionRequest, opts ...grpc.CallOption) (*Variant, error)
+	// 列出所有變體
+	ListVariants(ctx context.Context, in *ListVariantsRequest, opts ...grpc.CallOption) (*ListVariantsResponse, error)
+	// 以 Cloudflare 上的變體為準同步資料庫中的定義
+	SyncVariants(ctx context.Context, in *SyncVariantsRequest, opts ...grpc.CallOption) (*SyncVariantsResponse, error)
+	// 刪除變體
+	DeleteVariant(ctx context.Context, in *VariantRequest, opts ...grpc.CallOption) (*DeleteVariantResponse, error)
+}
+
+type variantServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVariantServiceClient(cc grpc.ClientConnInterface) VariantServiceClient {
+	return &variantServiceClient{cc}
+}
+
+func (c *variantServiceClient) CreateVariant(ctx context.Context, in *VariantDefinitionRequest, opts ...grpc.CallOption) (*Variant, error) {
+	out := new(Variant)
+	err := c.cc.Invoke(ctx, VariantService_CreateVariant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) UpdateVariant(ctx context.Context, in *VariantDefinitionRequest, opts ...grpc.CallOption) (*Variant, error) {
+	out := new(Variant)
+	err := c.cc.Invoke(ctx, VariantService_UpdateVariant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) ListVariants(ctx context.Context, in *ListVariantsRequest, opts ...grpc.CallOption) (*ListVariantsResponse, error) {
+	out := new(ListVariantsResponse)
+	err := c.cc.Invoke(ctx, VariantService_ListVariants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) SyncVariants(ctx context.Context, in *SyncVariantsRequest, opts ...grpc.CallOption) (*SyncVariantsResponse, error) {
+	out := new(SyncVariantsResponse)
+	err := c.cc.Invoke(ctx, VariantService_SyncVariants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *variantServiceClient) DeleteVariant(ctx context.Context, in *VariantRequest, opts ...grpc.CallOption) (*DeleteVariantResponse, error) {
+	out := new(DeleteVariantResponse)
+	err := c.cc.Invoke(ctx, VariantService_DeleteVariant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VariantServiceServer is the server API for VariantService service.
+// All implementations must embed UnimplementedVariantServiceServer
+// for forward compatibility
+type VariantServiceServer interface {
+	// 建立變體
+	CreateVariant(context.Context, *VariantDefinitionRequest) (*Variant, error)
+	// 更新變體的尺寸與處理方式
+	UpdateVariant(context.Context, *VariantDefinitionRequest) (*Variant, error)
+	// 列出所有變體
+	ListVariants(context.Context, *ListVariantsRequest) (*ListVariantsResponse, error)
+	// 以 Cloudflare 上的變體為準同步資料庫中的定義
+	SyncVariants(context.Context, *SyncVariantsRequest) (*SyncVariantsResponse, error)
+	// 刪除變體
+	DeleteVariant(context.Context, *VariantRequest) (*DeleteVariantResponse, error)
+	mustEmbedUnimplementedVariantServiceServer()
+}
+
+// UnimplementedVariantServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVariantServiceServer struct {
+}
+
+func (UnimplementedVariantServiceServer) CreateVariant(context.Context, *VariantDefinitionRequest) (*Variant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVariant not implemented")
+}
+func (UnimplementedVariantServiceServer) UpdateVariant(context.Context, *VariantDefinitionRequest) (*Variant, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVariant not implemented")
+}
+func (UnimplementedVariantServiceServer) ListVariants(context.Context, *ListVariantsRequest) (*ListVariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVariants not implemented")
+}
+func (UnimplementedVariantServiceServer) SyncVariants(context.Context, *SyncVariantsRequest) (*SyncVariantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncVariants not implemented")
+}
+func (UnimplementedVariantServiceServer) DeleteVariant(context.Context, *VariantRequest) (*DeleteVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVariant not implemented")
+}
+func (UnimplementedVariantServiceServer) mustEmbedUnimplementedVariantServiceServer() {}
+
+// UnsafeVariantServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VariantServiceServer will
+// result in compilation errors.
+type UnsafeVariantServiceServer interface {
+	mustEmbedUnimplementedVariantServiceServer()
+}
+
+func RegisterVariantServiceServer(s grpc.ServiceRegistrar, srv VariantServiceServer) {
+	s.RegisterService(&VariantService_ServiceDesc, srv)
+}
+
+func _VariantService_CreateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariantDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).CreateVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_CreateVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).CreateVariant(ctx, req.(*VariantDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_UpdateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariantDefinitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).UpdateVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_UpdateVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).UpdateVariant(ctx, req.(*VariantDefinitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_ListVariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).ListVariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_ListVariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).ListVariants(ctx, req.(*ListVariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_SyncVariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncVariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).SyncVariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_SyncVariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).SyncVariants(ctx, req.(*SyncVariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VariantService_DeleteVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VariantServiceServer).DeleteVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VariantService_DeleteVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VariantServiceServer).DeleteVariant(ctx, req.(*VariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VariantService_ServiceDesc is the grpc.ServiceDesc for VariantService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VariantService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mediaService.VariantService",
+	HandlerType: (*VariantServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVariant",
+			Handler:    _VariantService_CreateVariant_Handler,
+		},
+		{
+			MethodName: "UpdateVariant",
+			Handler:    _VariantService_UpdateVariant_Handler,
+		},
+		{
+			MethodName: "ListVariants",
+			Handler:    _VariantService_ListVariants_Handler,
+		},
+		{
+			MethodName: "SyncVariants",
+			Handler:    _VariantService_SyncVariants_Handler,
+		},
+		{
+			MethodName: "DeleteVariant",
+			Handler:    _VariantService_DeleteVariant_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/variant.proto",
+}
@@ -0,0 +1,602 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: proto/watermark.proto
+
+package watermark
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Watermark with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Watermark) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Watermark with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatermarkMultiError, or nil
+// if none found.
+func (m *Watermark) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Watermark) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WatermarkId
+
+	// no validation rules for OwnerId
+
+	// no validation rules for AlbumId
+
+	// no validation rules for Type
+
+	// no validation rules for Text
+
+	// no validation rules for OverlayImageId
+
+	// no validation rules for Position
+
+	// no validation rules for Opacity
+
+	// no validation rules for Scale
+
+	// no validation rules for Mode
+
+	// no validation rules for UpdatedAt
+
+	if len(errors) > 0 {
+		return WatermarkMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatermarkMultiError is an error wrapping multiple validation errors returned
+// by Watermark.ValidateAll() if the designated constraints aren't met.
+type WatermarkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatermarkMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatermarkMultiError) AllErrors() []error { return m }
+
+// WatermarkValidationError is the validation error returned by
+// Watermark.Validate if the designated constraints aren't met.
+type WatermarkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatermarkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatermarkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatermarkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatermarkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatermarkValidationError) ErrorName() string { return "WatermarkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatermarkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatermark.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatermarkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatermarkValidationError{}
+
+// Validate checks the field values on SetWatermarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetWatermarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetWatermarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetWatermarkRequestMultiError, or nil if none found.
+func (m *SetWatermarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetWatermarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAlbumId() != "" {
+
+		if !_SetWatermarkRequest_AlbumId_Pattern.MatchString(m.GetAlbumId()) {
+			err := SetWatermarkRequestValidationError{
+				field:  "AlbumId",
+				reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _SetWatermarkRequest_Type_InLookup[m.GetType()]; !ok {
+		err := SetWatermarkRequestValidationError{
+			field:  "Type",
+			reason: "value must be in list [text image]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetText()) > 100 {
+		err := SetWatermarkRequestValidationError{
+			field:  "Text",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOverlayImageId() != "" {
+
+		if !_SetWatermarkRequest_OverlayImageId_Pattern.MatchString(m.GetOverlayImageId()) {
+			err := SetWatermarkRequestValidationError{
+				field:  "OverlayImageId",
+				reason: "value does not match regex pattern \"^[a-zA-Z0-9-]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := _SetWatermarkRequest_Position_InLookup[m.GetPosition()]; !ok {
+		err := SetWatermarkRequestValidationError{
+			field:  "Position",
+			reason: "value must be in list [top-left top top-right left center right bottom-left bottom bottom-right]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetOpacity(); val <= 0 || val > 1 {
+		err := SetWatermarkRequestValidationError{
+			field:  "Opacity",
+			reason: "value must be inside range (0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetScale(); val <= 0 || val > 1 {
+		err := SetWatermarkRequestValidationError{
+			field:  "Scale",
+			reason: "value must be inside range (0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetWatermarkRequest_Mode_InLookup[m.GetMode()]; !ok {
+		err := SetWatermarkRequestValidationError{
+			field:  "Mode",
+			reason: "value must be in list [overlay burn]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetWatermarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetWatermarkRequestMultiError is an error wrapping multiple validation
+// errors returned by SetWatermarkRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetWatermarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetWatermarkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetWatermarkRequestMultiError) AllErrors() []error { return m }
+
+// SetWatermarkRequestValidationError is the validation error returned by
+// SetWatermarkRequest.Validate if the designated constraints aren't met.
+type SetWatermarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetWatermarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetWatermarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetWatermarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetWatermarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetWatermarkRequestValidationError) ErrorName() string {
+	return "SetWatermarkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetWatermarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetWatermarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetWatermarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetWatermarkRequestValidationError{}
+
+var _SetWatermarkRequest_AlbumId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+var _SetWatermarkRequest_Type_InLookup = map[string]struct{}{
+	"text":  {},
+	"image": {},
+}
+
+var _SetWatermarkRequest_OverlayImageId_Pattern = regexp.MustCompile("^[a-zA-Z0-9-]+$")
+
+var _SetWatermarkRequest_Position_InLookup = map[string]struct{}{
+	"top-left":     {},
+	"top":          {},
+	"top-right":    {},
+	"left":         {},
+	"center":       {},
+	"right":        {},
+	"bottom-left":  {},
+	"bottom":       {},
+	"bottom-right": {},
+}
+
+var _SetWatermarkRequest_Mode_InLookup = map[string]struct{}{
+	"overlay": {},
+	"burn":    {},
+}
+
+// Validate checks the field values on WatermarkRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatermarkRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatermarkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatermarkRequestMultiError, or nil if none found.
+func (m *WatermarkRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatermarkRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAlbumId() != "" {
+
+		if !_WatermarkRequest_AlbumId_Pattern.MatchString(m.GetAlbumId()) {
+			err := WatermarkRequestValidationError{
+				field:  "AlbumId",
+				reason: "value does not match regex pattern \"^[a-f0-9]{24}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WatermarkRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatermarkRequestMultiError is an error wrapping multiple validation errors
+// returned by WatermarkRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatermarkRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatermarkRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatermarkRequestMultiError) AllErrors() []error { return m }
+
+// WatermarkRequestValidationError is the validation error returned by
+// WatermarkRequest.Validate if the designated constraints aren't met.
+type WatermarkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatermarkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatermarkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatermarkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatermarkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatermarkRequestValidationError) ErrorName() string { return "WatermarkRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WatermarkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatermarkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatermarkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatermarkRequestValidationError{}
+
+var _WatermarkRequest_AlbumId_Pattern = regexp.MustCompile("^[a-f0-9]{24}$")
+
+// Validate checks the field values on DeleteWatermarkResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWatermarkResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWatermarkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWatermarkResponseMultiError, or nil if none found.
+func (m *DeleteWatermarkResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWatermarkResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DeleteWatermarkResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWatermarkResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteWatermarkResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteWatermarkResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWatermarkResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWatermarkResponseMultiError) AllErrors() []error { return m }
+
+// DeleteWatermarkResponseValidationError is the validation error returned by
+// DeleteWatermarkResponse.Validate if the designated constraints aren't met.
+type DeleteWatermarkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWatermarkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWatermarkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWatermarkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWatermarkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWatermarkResponseValidationError) ErrorName() string {
+	return "DeleteWatermarkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWatermarkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWatermarkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWatermarkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWatermarkResponseValidationError{}
@@ -0,0 +1,189 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: proto/watermark.proto
+
+package watermark
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	WatermarkService_SetWatermark_FullMethodName    = "/mediaService.WatermarkService/SetWatermark"
+	WatermarkService_GetWatermark_FullMethodName    = "/mediaService.WatermarkService/GetWatermark"
+	WatermarkService_DeleteWatermark_FullMethodName = "/mediaService.WatermarkService/DeleteWatermark"
+)
+
+// WatermarkServiceClient is the client API for WatermarkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WatermarkServiceClient interface {
+	// 設定擁有者或相簿的浮水印
+	SetWatermark(ctx context.Context, in *SetWatermarkRequest, opts ...grpc.CallOption) (*Watermark, error)
+	// 取得擁有者或相簿的浮水印
+	GetWatermark(ctx context.Context, in *WatermarkRequest, opts ...grpc.CallOption) (*Watermark, error)
+	// 刪除擁有者或相簿的浮水印
+	DeleteWatermark(ctx context.Context, in *WatermarkRequest, opts ...grpc.CallOption) (*DeleteWatermarkResponse, error)
+}
+
+type watermarkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatermarkServiceClient(cc grpc.ClientConnInterface) WatermarkServiceClient {
+	return &watermarkServiceClient{cc}
+}
+
+func (c *watermarkServiceClient) SetWatermark(ctx context.Context, in *SetWatermarkRequest, opts ...grpc.CallOption) (*Watermark, error) {
+	out := new(Watermark)
+	err := c.cc.Invoke(ctx, WatermarkService_SetWatermark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watermarkServiceClient) GetWatermark(ctx context.Context, in *WatermarkRequest, opts ...grpc.CallOption) (*Watermark, error) {
+	out := new(Watermark)
+	err := c.cc.Invoke(ctx, WatermarkService_GetWatermark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watermarkServiceClient) DeleteWatermark(ctx context.Context, in *WatermarkRequest, opts ...grpc.CallOption) (*DeleteWatermarkResponse, error) {
+	out := new(DeleteWatermarkResponse)
+	err := c.cc.Invoke(ctx, WatermarkService_DeleteWatermark_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WatermarkServiceServer is the server API for WatermarkService service.
+// All implementations must embed UnimplementedWatermarkServiceServer
+// for forward compatibility
+type WatermarkServiceServer interface {
+	// 設定擁有者或相簿的浮水印
+	SetWatermark(context.Context, *SetWatermarkRequest) (*Watermark, error)
+	// 取得擁有者或相簿的浮水印
+	GetWatermark(context.Context, *WatermarkRequest) (*Watermark, error)
+	// 刪除擁有者或相簿的浮水印
+	DeleteWatermark(context.Context, *WatermarkRequest) (*DeleteWatermarkResponse, error)
+	mustEmbedUnimplementedWatermarkServiceServer()
+}
+
+// UnimplementedWatermarkServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWatermarkServiceServer struct {
+}
+
+func (UnimplementedWatermarkServiceServer) SetWatermark(context.Context, *SetWatermarkRequest) (*Watermark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWatermark not implemented")
+}
+func (UnimplementedWatermarkServiceServer) GetWatermark(context.Context, *WatermarkRequest) (*Watermark, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWatermark not implemented")
+}
+func (UnimplementedWatermarkServiceServer) DeleteWatermark(context.Context, *WatermarkRequest) (*DeleteWatermarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWatermark not implemented")
+}
+func (UnimplementedWatermarkServiceServer) mustEmbedUnimplementedWatermarkServiceServer() {}
+
+// UnsafeWatermarkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatermarkServiceServer will
+// result in compilation errors.
+type UnsafeWatermarkServiceServer interface {
+	mustEmbedUnimplementedWatermarkServiceServer()
+}
+
+func RegisterWatermarkServiceServer(s grpc.ServiceRegistrar, srv WatermarkServiceServer) {
+	s.RegisterService(&WatermarkService_ServiceDesc, srv)
+}
+
+func _WatermarkService_SetWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWatermarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatermarkServiceServer).SetWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatermarkService_SetWatermark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatermarkServiceServer).SetWatermark(ctx, req.(*SetWatermarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatermarkService_GetWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatermarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatermarkServiceServer).GetWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatermarkService_GetWatermark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatermarkServiceServer).GetWatermark(ctx, req.(*WatermarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatermarkService_DeleteWatermark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatermarkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatermarkServiceServer).DeleteWatermark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatermarkService_DeleteWatermark_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatermarkServiceServer).DeleteWatermark(ctx, req.(*WatermarkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WatermarkService_ServiceDesc is the grpc.ServiceDesc for WatermarkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WatermarkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mediaService.WatermarkService",
+	HandlerType: (*WatermarkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetWatermark",
+			Handler:    _WatermarkService_SetWatermark_Handler,
+		},
+		{
+			MethodName: "GetWatermark",
+			Handler:    _WatermarkService_GetWatermark_Handler,
+		},
+		{
+			MethodName: "DeleteWatermark",
+			Handler:    _WatermarkService_DeleteWatermark_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/watermark.proto",
+}
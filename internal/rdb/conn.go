@@ -0,0 +1,70 @@
+package rdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+var conn *redis.Client
+
+type connOption func(*redis.Options)
+
+func WithAddr(addr string) connOption {
+	return func(o *redis.Options) {
+		o.Addr = addr
+	}
+}
+
+func WithPassword(password string) connOption {
+	return func(o *redis.Options) {
+		o.Password = password
+	}
+}
+
+func WithDb(db int) connOption {
+	return func(o *redis.Options) {
+		o.DB = db
+	}
+}
+
+// InitConnection 建立 media 服務自用的 Redis 連線。
+// vulpes 的 cache 只提供簡單的 key/value 操作，計數器的原子交換等功能需要直接使用 Redis 指令。
+func InitConnection(opts ...connOption) error {
+	if conn != nil {
+		return nil
+	}
+	redisOpts := &redis.Options{
+		PoolSize:     10,
+		MinIdleConns: 3,
+		DialTimeout:  5 * time.Second,
+		ReadTimeout:  3 * time.Second,
+		WriteTimeout: 3 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(redisOpts)
+	}
+	client := redis.NewClient(redisOpts)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		return wrapErr(ErrNotConnected, err)
+	}
+	conn = client
+	return nil
+}
+
+func Close() error {
+	if conn == nil {
+		return nil
+	}
+	return conn.Close()
+}
+
+func client() (*redis.Client, error) {
+	if conn == nil {
+		return nil, ErrNotConnected
+	}
+	return conn, nil
+}
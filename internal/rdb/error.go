@@ -0,0 +1,54 @@
+package rdb
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrNotConnected = errors.New("redis not connected")
+	ErrQueryFailed  = errors.New("redis query failed")
+	ErrLocked       = errors.New("redis lock held by another worker")
+	ErrLockLost     = errors.New("redis lock is no longer held")
+)
+
+func wrapErr(base, err error) error {
+	return fmt.Errorf("%w: %w", base, err)
+}
+
+func ToStatus(err error) *status.Status {
+	if err == nil {
+		return nil
+	}
+	code := codes.Internal
+	switch {
+	case errors.Is(err, ErrNotConnected):
+		code = codes.Unavailable
+	case errors.Is(err, ErrLocked), errors.Is(err, ErrLockLost):
+		code = codes.Aborted
+	}
+	unwrapErr := errors.Unwrap(err)
+	if unwrapErr == nil {
+		unwrapErr = err
+	}
+	baseErrStatus := status.New(code, err.Error())
+	st, myErr := baseErrStatus.WithDetails(
+		&errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{
+				{
+					Type:        "MEDIA_REDIS",
+					Subject:     unwrapErr.Error(),
+					Description: err.Error(),
+				},
+			},
+		},
+	)
+	if myErr != nil {
+		return baseErrStatus
+	}
+	return st
+}
@@ -0,0 +1,123 @@
+package rdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+func imageVariantsKey(imageId string) string {
+	return fmt.Sprintf("%s:image:variants:%s", keyPrefix, imageId)
+}
+
+func imageDeliveryKey(imageId string) string {
+	return fmt.Sprintf("%s:image:delivery:%s", keyPrefix, imageId)
+}
+
+// GetImageVariants 讀取快取的圖片變體，沒有快取時 ok 為 false。
+func GetImageVariants(ctx context.Context, imageId string) (map[string]string, bool, error) {
+	clt, err := client()
+	if err != nil {
+		return nil, false, err
+	}
+	variants, err := clt.HGetAll(ctx, imageVariantsKey(imageId)).Result()
+	if err != nil {
+		return nil, false, wrapErr(ErrQueryFailed, err)
+	}
+	return variants, len(variants) > 0, nil
+}
+
+// SetImageVariants 快取圖片的變體，避免每次取得 URI 都查詢資料庫。
+func SetImageVariants(ctx context.Context, imageId string, variants map[string]string, ttl time.Duration) error {
+	if len(variants) == 0 {
+		return nil
+	}
+	clt, err := client()
+	if err != nil {
+		return err
+	}
+	key := imageVariantsKey(imageId)
+	pipe := clt.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.HSet(ctx, key, variants)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return wrapErr(ErrQueryFailed, err)
+	}
+	return nil
+}
+
+// GetImageDelivery 讀取快取的圖片傳遞設定（擁有者與浮水印），沒有快取時 ok 為 false。
+func GetImageDelivery(ctx context.Context, imageId string) (string, bool, error) {
+	clt, err := client()
+	if err != nil {
+		return "", false, err
+	}
+	value, err := clt.Get(ctx, imageDeliveryKey(imageId)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", false, nil
+		}
+		return "", false, wrapErr(ErrQueryFailed, err)
+	}
+	return value, true, nil
+}
+
+// SetImageDelivery 快取圖片的傳遞設定，浮水印設定或相簿成員變更時由 InvalidateImageDelivery 清除。
+func SetImageDelivery(ctx context.Context, imageId, value string, ttl time.Duration) error {
+	clt, err := client()
+	if err != nil {
+		return err
+	}
+	if err := clt.Set(ctx, imageDeliveryKey(imageId), value, ttl).Err(); err != nil {
+		return wrapErr(ErrQueryFailed, err)
+	}
+	return nil
+}
+
+// InvalidateImageVariants 移除圖片變體與傳遞設定的快取，圖片內容更換或刪除時使用。
+func InvalidateImageVariants(ctx context.Context, imageIds ...string) error {
+	if len(imageIds) == 0 {
+		return nil
+	}
+	clt, err := client()
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(imageIds)*2)
+	for _, id := range imageIds {
+		keys = append(keys, imageVariantsKey(id), imageDeliveryKey(id))
+	}
+	if err := clt.Del(ctx, keys...).Err(); err != nil {
+		return wrapErr(ErrQueryFailed, err)
+	}
+	return nil
+}
+
+// 每次刪除的 key 數量上限，避免擁有大量圖片時單一指令過大。
+const invalidateBatchSize = 500
+
+// InvalidateImageDelivery 清除圖片傳遞設定的快取，用於浮水印或相簿成員變更後立即生效。
+func InvalidateImageDelivery(ctx context.Context, imageIds ...string) error {
+	if len(imageIds) == 0 {
+		return nil
+	}
+	clt, err := client()
+	if err != nil {
+		return err
+	}
+	for start := 0; start < len(imageIds); start += invalidateBatchSize {
+		end := min(start+invalidateBatchSize, len(imageIds))
+		keys := make([]string, 0, end-start)
+		for _, id := range imageIds[start:end] {
+			keys = append(keys, imageDeliveryKey(id))
+		}
+		if err := clt.Del(ctx, keys...).Err(); err != nil {
+			return wrapErr(ErrQueryFailed, err)
+		}
+	}
+	return nil
+}
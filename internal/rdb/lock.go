@@ -0,0 +1,75 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+var extendScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+func lockKey(name string) string {
+	return fmt.Sprintf("%s:lock:%s", keyPrefix, name)
+}
+
+// Lock 是以 SET NX 取得的分散式鎖，只會延長或釋放自己持有的鎖。
+type Lock struct {
+	clt   *redis.Client
+	key   string
+	token string
+}
+
+// acquireLock 以 SET NX 取得分散式鎖，已被其他實例持有時回傳 ErrLocked。
+func acquireLock(ctx context.Context, key string, ttl time.Duration) (*Lock, error) {
+	clt, err := client()
+	if err != nil {
+		return nil, err
+	}
+	token := uuid.NewString()
+	ok, err := clt.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return nil, wrapErr(ErrQueryFailed, err)
+	}
+	if !ok {
+		return nil, ErrLocked
+	}
+	return &Lock{clt: clt, key: key, token: token}, nil
+}
+
+// Extend 將鎖的有效期限延長為 ttl；鎖已過期或被其他實例取得時回傳 ErrLockLost，呼叫端應停止處理。
+func (l *Lock) Extend(ctx context.Context, ttl time.Duration) error {
+	n, err := extendScript.Run(ctx, l.clt, []string{l.key}, l.token, ttl.Milliseconds()).Int()
+	if err != nil {
+		return wrapErr(ErrQueryFailed, err)
+	}
+	if n == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+// Unlock 釋放鎖，鎖已被其他實例取得時不會刪除。
+func (l *Lock) Unlock(ctx context.Context) error {
+	if err := unlockScript.Run(ctx, l.clt, []string{l.key}, l.token).Err(); err != nil {
+		return wrapErr(ErrQueryFailed, err)
+	}
+	return nil
+}
+
+// LockImageGC 取得圖片回收的分散式鎖，同一時間只有一個實例在刪除未使用的圖片。
+func LockImageGC(ctx context.Context, ttl time.Duration) (*Lock, error) {
+	return acquireLock(ctx, lockKey("image:gc"), ttl)
+}
@@ -0,0 +1,244 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	rankAllSuffix      = "all"
+	rankDaySuffix      = "day"
+	rankWeekSuffix     = "week"
+	rankTrendingSuffix = "trending"
+
+	rankDayLayout = "20060102"
+	rankDayTTL    = 8 * 24 * time.Hour
+	rankWeekTTL   = 5 * time.Minute
+	rankWeekDays  = 7
+	rankScopeTTL  = 30 * time.Second
+)
+
+// RankPeriod 指定排行榜統計的期間。
+type RankPeriod int
+
+const (
+	RankAllTime RankPeriod = iota
+	RankDay
+	RankWeek
+)
+
+func rankKey(suffix string) string {
+	return fmt.Sprintf("{%s:image:rank}:%s", keyPrefix, suffix)
+}
+
+func rankDayKey(t time.Time) string {
+	return rankKey(rankDaySuffix + ":" + t.UTC().Format(rankDayLayout))
+}
+
+// trendingScript 以 log-sum-exp 累加時間衰減的分數：
+// score = log2(Σ 2^((t_i - epoch) / halfLife))，數值不會隨時間無限制成長。
+// 超過 ARGV[3] 筆時移除分數最低的圖片，避免趨勢榜無限制成長。
+var trendingScript = redis.NewScript(`
+local inc = tonumber(ARGV[2])
+local cur = redis.call("ZSCORE", KEYS[1], ARGV[1])
+if cur then
+	cur = tonumber(cur)
+	local hi, lo = math.max(cur, inc), math.min(cur, inc)
+	inc = hi + math.log(1 + 2 ^ (lo - hi)) / math.log(2)
+end
+redis.call("ZADD", KEYS[1], inc, ARGV[1])
+local max = tonumber(ARGV[3])
+if max > 0 and redis.call("ZCARD", KEYS[1]) > max then
+	redis.call("ZREMRANGEBYRANK", KEYS[1], 0, -max - 1)
+end
+return tostring(inc)`)
+
+// TrendingScore 回傳在 viewedAt 發生一次瀏覽所對應的 log2 分數。
+// 每經過一個 halfLife，相同的瀏覽次數所代表的分數就少 1（也就是權重減半）。
+func TrendingScore(viewedAt time.Time, halfLife time.Duration) float64 {
+	if halfLife <= 0 {
+		return 0
+	}
+	return float64(viewedAt.Unix()) / halfLife.Seconds()
+}
+
+// DecayedViews 將 TrendingScore 累加後的分數換算回 now 時間點的等效瀏覽次數。
+func DecayedViews(score float64, now time.Time, halfLife time.Duration) float64 {
+	return math.Pow(2, score-TrendingScore(now, halfLife))
+}
+
+// IncrImageRank 在排行榜中為圖片記錄一次瀏覽：累計、當日以及趨勢分數，趨勢榜最多保留 trendingMax 張圖片（0 表示不限）。
+func IncrImageRank(ctx context.Context, imageId string, halfLife time.Duration, trendingMax int64) error {
+	clt, err := client()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	dayKey := rankDayKey(now)
+	pipe := clt.Pipeline()
+	pipe.ZIncrBy(ctx, rankKey(rankAllSuffix), 1, imageId)
+	pipe.ZIncrBy(ctx, dayKey, 1, imageId)
+	pipe.Expire(ctx, dayKey, rankDayTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return wrapErr(ErrQueryFailed, err)
+	}
+	// script 不放在 pipeline 中：Run 在 Redis 尚未載入 script 時會改用 EVAL 重試
+	err = trendingScript.Run(ctx, clt, []string{rankKey(rankTrendingSuffix)}, imageId, TrendingScore(now, halfLife), trendingMax).Err()
+	if err != nil {
+		return wrapErr(ErrQueryFailed, err)
+	}
+	return nil
+}
+
+// RemoveImageRank 將已刪除的圖片從所有排行榜中移除。
+func RemoveImageRank(ctx context.Context, imageIds ...string) error {
+	if len(imageIds) == 0 {
+		return nil
+	}
+	clt, err := client()
+	if err != nil {
+		return err
+	}
+	members := make([]any, len(imageIds))
+	for i, id := range imageIds {
+		members[i] = id
+	}
+	pipe := clt.Pipeline()
+	pipe.ZRem(ctx, rankKey(rankAllSuffix), members...)
+	pipe.ZRem(ctx, rankKey(rankTrendingSuffix), members...)
+	now := time.Now()
+	for i := 0; i <= rankWeekDays; i++ {
+		pipe.ZRem(ctx, rankDayKey(now.AddDate(0, 0, -i)), members...)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return wrapErr(ErrQueryFailed, err)
+	}
+	return nil
+}
+
+// RankedImage 是排行榜中的一筆資料。
+type RankedImage struct {
+	ImageID string
+	Score   float64
+}
+
+// periodRankKey 回傳期間對應的 sorted set；週排行由最近七天的日排行合併並短暫快取。
+func periodRankKey(ctx context.Context, clt *redis.Client, period RankPeriod) (string, error) {
+	now := time.Now()
+	switch period {
+	case RankDay:
+		return rankDayKey(now), nil
+	case RankWeek:
+		weekKey := rankKey(rankWeekSuffix + ":" + now.UTC().Format(rankDayLayout))
+		exists, err := clt.Exists(ctx, weekKey).Result()
+		if err != nil {
+			return "", wrapErr(ErrQueryFailed, err)
+		}
+		if exists > 0 {
+			return weekKey, nil
+		}
+		days := make([]string, rankWeekDays)
+		for i := range days {
+			days[i] = rankDayKey(now.AddDate(0, 0, -i))
+		}
+		pipe := clt.TxPipeline()
+		pipe.ZUnionStore(ctx, weekKey, &redis.ZStore{Keys: days})
+		pipe.Expire(ctx, weekKey, rankWeekTTL)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return "", wrapErr(ErrQueryFailed, err)
+		}
+		return weekKey, nil
+	default:
+		return rankKey(rankAllSuffix), nil
+	}
+}
+
+// TopImages 回傳期間內瀏覽次數最高的圖片，從第 offset 名開始。
+func TopImages(ctx context.Context, period RankPeriod, offset, limit int) ([]RankedImage, error) {
+	clt, err := client()
+	if err != nil {
+		return nil, err
+	}
+	key, err := periodRankKey(ctx, clt, period)
+	if err != nil {
+		return nil, err
+	}
+	return revRange(ctx, clt, key, offset, limit)
+}
+
+// TrendingImages 回傳時間衰減分數最高的圖片，從第 offset 名開始。
+func TrendingImages(ctx context.Context, offset, limit int) ([]RankedImage, error) {
+	clt, err := client()
+	if err != nil {
+		return nil, err
+	}
+	return revRange(ctx, clt, rankKey(rankTrendingSuffix), offset, limit)
+}
+
+// TopImagesIn 回傳指定圖片在期間內分數最高的 limit 張，沒有瀏覽紀錄的圖片不會出現在結果中。
+func TopImagesIn(ctx context.Context, period RankPeriod, imageIds []string, limit int) ([]RankedImage, error) {
+	clt, err := client()
+	if err != nil {
+		return nil, err
+	}
+	key, err := periodRankKey(ctx, clt, period)
+	if err != nil {
+		return nil, err
+	}
+	return rankAmong(ctx, clt, key, imageIds, limit)
+}
+
+// TrendingImagesIn 回傳指定圖片中趨勢分數最高的 limit 張，沒有瀏覽紀錄的圖片不會出現在結果中。
+func TrendingImagesIn(ctx context.Context, imageIds []string, limit int) ([]RankedImage, error) {
+	clt, err := client()
+	if err != nil {
+		return nil, err
+	}
+	return rankAmong(ctx, clt, rankKey(rankTrendingSuffix), imageIds, limit)
+}
+
+// rankAmong 在 Redis 中以 ZINTERSTORE 取出指定圖片的分數並排序，再回傳前 limit 名。
+// 暫存的 key 與排行榜使用相同的 hash tag，在 Redis Cluster 下落在同一個 slot。
+func rankAmong(ctx context.Context, clt *redis.Client, key string, imageIds []string, limit int) ([]RankedImage, error) {
+	if len(imageIds) == 0 || limit <= 0 {
+		return nil, nil
+	}
+	scope := rankKey(fmt.Sprintf("scope:%d", time.Now().UnixNano()))
+	ranked := scope + ":ranked"
+	members := make([]any, len(imageIds))
+	for i, id := range imageIds {
+		members[i] = id
+	}
+	pipe := clt.TxPipeline()
+	pipe.SAdd(ctx, scope, members...)
+	pipe.Expire(ctx, scope, rankScopeTTL)
+	pipe.ZInterStore(ctx, ranked, &redis.ZStore{Keys: []string{key, scope}, Weights: []float64{1, 0}})
+	pipe.Expire(ctx, ranked, rankScopeTTL)
+	values := pipe.ZRevRangeWithScores(ctx, ranked, 0, int64(limit-1))
+	pipe.Del(ctx, scope, ranked)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, wrapErr(ErrQueryFailed, err)
+	}
+	return toRankedImages(values.Val()), nil
+}
+
+func revRange(ctx context.Context, clt *redis.Client, key string, offset, limit int) ([]RankedImage, error) {
+	values, err := clt.ZRevRangeWithScores(ctx, key, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, wrapErr(ErrQueryFailed, err)
+	}
+	return toRankedImages(values), nil
+}
+
+func toRankedImages(values []redis.Z) []RankedImage {
+	result := make([]RankedImage, len(values))
+	for i, z := range values {
+		member, _ := z.Member.(string)
+		result[i] = RankedImage{ImageID: member, Score: z.Score}
+	}
+	return result
+}
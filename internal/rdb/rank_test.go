@@ -0,0 +1,34 @@
+package rdb
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// logSumExp 與 trendingScript 中的累加方式相同。
+func logSumExp(a, b float64) float64 {
+	hi, lo := math.Max(a, b), math.Min(a, b)
+	return hi + math.Log2(1+math.Pow(2, lo-hi))
+}
+
+func TestTrendingDecay(t *testing.T) {
+	halfLife := 24 * time.Hour
+	now := time.Date(2025, 8, 20, 12, 0, 0, 0, time.UTC)
+
+	// 一次剛發生的瀏覽等於 1 次
+	assert.InDelta(t, 1, DecayedViews(TrendingScore(now, halfLife), now, halfLife), 1e-9)
+
+	// 一個半衰期前的瀏覽只剩一半
+	assert.InDelta(t, 0.5, DecayedViews(TrendingScore(now.Add(-halfLife), halfLife), now, halfLife), 1e-9)
+
+	// 兩次瀏覽（現在與一天前）累加後為 1.5 次
+	score := logSumExp(TrendingScore(now, halfLife), TrendingScore(now.Add(-halfLife), halfLife))
+	assert.InDelta(t, 1.5, DecayedViews(score, now, halfLife), 1e-9)
+}
+
+func TestTrendingScoreZeroHalfLife(t *testing.T) {
+	assert.Equal(t, float64(0), TrendingScore(time.Now(), 0))
+}
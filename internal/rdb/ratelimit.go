@@ -0,0 +1,67 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// tokenBucketScript 實作 token bucket：桶子容量為 limit，每個 window 補滿一次。
+// 回傳 {是否允許, 需等待的毫秒數, 剩餘 token}。
+var tokenBucketScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local rate = capacity / window
+local data = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(data[1]) or capacity
+local ts = tonumber(data[2]) or now
+if now > ts then
+	tokens = math.min(capacity, tokens + (now - ts) * rate)
+end
+local allowed, retry = 0, 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate)
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", tostring(math.max(now, ts)))
+redis.call("PEXPIRE", KEYS[1], window)
+return {allowed, retry, math.floor(tokens)}`)
+
+// RateLimitResult 是一次限流檢查的結果。
+type RateLimitResult struct {
+	Allowed    bool
+	RetryAfter time.Duration
+	Remaining  int64
+}
+
+func rateLimitKey(scope, subject string) string {
+	return fmt.Sprintf("%s:ratelimit:%s:%s", keyPrefix, scope, subject)
+}
+
+// AllowRate 以 token bucket 檢查 subject 在 scope 下是否還能發出請求，每個 window 最多 limit 次。
+func AllowRate(ctx context.Context, scope, subject string, limit int64, window time.Duration) (*RateLimitResult, error) {
+	clt, err := client()
+	if err != nil {
+		return nil, err
+	}
+	values, err := tokenBucketScript.Run(ctx, clt,
+		[]string{rateLimitKey(scope, subject)},
+		limit, window.Milliseconds(), time.Now().UnixMilli(),
+	).Int64Slice()
+	if err != nil {
+		return nil, wrapErr(ErrQueryFailed, err)
+	}
+	if len(values) != 3 {
+		return nil, fmt.Errorf("%w: unexpected rate limit result %v", ErrQueryFailed, values)
+	}
+	return &RateLimitResult{
+		Allowed:    values[0] == 1,
+		RetryAfter: time.Duration(values[1]) * time.Millisecond,
+		Remaining:  values[2],
+	}, nil
+}
@@ -0,0 +1,191 @@
+package rdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+var keyPrefix = "media"
+
+// SetKeyPrefix 設定所有 media 服務 Redis key 的命名空間前綴。
+func SetKeyPrefix(prefix string) {
+	if prefix == "" {
+		return
+	}
+	keyPrefix = strings.TrimSuffix(prefix, ":")
+}
+
+// viewKey 以 hash tag 包住計數器的基底名稱，讓 RENAME 在 Redis Cluster 下仍落在同一個 slot。
+func viewKey(suffix string) string {
+	return fmt.Sprintf("{%s:image:view}:%s", keyPrefix, suffix)
+}
+
+const (
+	viewPendingSuffix  = "pending"
+	viewDrainingSuffix = "draining"
+	viewLockSuffix     = "lock"
+)
+
+const (
+	viewFieldSep = "@"
+
+	// viewStatsAppliedPrefix 標記統計已寫入、只剩 images.count 尚未累加的欄位。
+	viewStatsAppliedPrefix = "~"
+)
+
+// ImageViewField 組成 pending hash 的欄位名稱：<image id>@<瀏覽發生的整點 unix 秒數>，
+// 讓同步時可以把瀏覽次數歸到正確的統計區間。
+func ImageViewField(imageId string, viewedAt time.Time) string {
+	hour := viewedAt.UTC().Truncate(time.Hour).Unix()
+	return imageId + viewFieldSep + strconv.FormatInt(hour, 10)
+}
+
+// ParseImageViewField 解析 ImageViewField 產生的欄位名稱。
+// 沒有時間資訊的舊格式欄位（只有 image id）回傳 zero time，由呼叫端決定歸屬的區間。
+func ParseImageViewField(field string) (string, time.Time) {
+	idx := strings.LastIndex(field, viewFieldSep)
+	if idx < 0 {
+		return field, time.Time{}
+	}
+	hour, err := strconv.ParseInt(field[idx+1:], 10, 64)
+	if err != nil {
+		return field, time.Time{}
+	}
+	return field[:idx], time.Unix(hour, 0).UTC()
+}
+
+// SplitStatsApplied 判斷欄位是否已由 MarkImageViewStatsApplied 標記，並回傳原本的欄位名稱。
+func SplitStatsApplied(field string) (string, bool) {
+	if strings.HasPrefix(field, viewStatsAppliedPrefix) {
+		return strings.TrimPrefix(field, viewStatsAppliedPrefix), true
+	}
+	return field, false
+}
+
+// IncrImageView 將圖片的瀏覽次數加一，累積在 pending hash 中等待同步。
+func IncrImageView(ctx context.Context, imageId string) error {
+	clt, err := client()
+	if err != nil {
+		return err
+	}
+	field := ImageViewField(imageId, time.Now())
+	if err := clt.HIncrBy(ctx, viewKey(viewPendingSuffix), field, 1).Err(); err != nil {
+		return wrapErr(ErrQueryFailed, err)
+	}
+	return nil
+}
+
+// LockImageViewFlush 取得同步計數器的分散式鎖，避免多個實例同時處理同一批計數。
+// 同步時間可能超過 ttl，呼叫端需要在每次寫入資料庫前以 Extend 確認仍持有鎖。
+func LockImageViewFlush(ctx context.Context, ttl time.Duration) (*Lock, error) {
+	return acquireLock(ctx, viewKey(viewLockSuffix), ttl)
+}
+
+// swapScript 只在 pending hash 存在時 RENAME，不存在時回傳 nil，兩個 key 共用 hash tag 因此可在同一個 script 中操作。
+var swapScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return redis.call("RENAME", KEYS[1], KEYS[2])
+end
+return false`)
+
+// SwapImageViewCounts 以 RENAME 原子地將 pending hash 換成一個新的 draining hash，
+// 之後的瀏覽會累積到新的 pending hash。沒有待同步的計數時回傳空字串。
+func SwapImageViewCounts(ctx context.Context) (string, error) {
+	clt, err := client()
+	if err != nil {
+		return "", err
+	}
+	draining := viewKey(fmt.Sprintf("%s:%d", viewDrainingSuffix, time.Now().UnixNano()))
+	err = swapScript.Run(ctx, clt, []string{viewKey(viewPendingSuffix), draining}).Err()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	if err != nil {
+		return "", wrapErr(ErrQueryFailed, err)
+	}
+	return draining, nil
+}
+
+// ListDrainingImageViews 列出所有尚未處理完成的 draining hash，包含先前同步失敗留下的。
+func ListDrainingImageViews(ctx context.Context) ([]string, error) {
+	clt, err := client()
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	iter := clt.Scan(ctx, 0, viewKey(viewDrainingSuffix+":*"), 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return nil, wrapErr(ErrQueryFailed, err)
+	}
+	return keys, nil
+}
+
+// GetImageViewCounts 讀取 draining hash 中每個欄位（見 ImageViewField）累積的瀏覽次數，無法解析的值以 0 回傳。
+func GetImageViewCounts(ctx context.Context, key string) (map[string]int64, error) {
+	clt, err := client()
+	if err != nil {
+		return nil, err
+	}
+	values, err := clt.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, wrapErr(ErrQueryFailed, err)
+	}
+	result := make(map[string]int64, len(values))
+	for field, v := range values {
+		count, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || count < 0 {
+			count = 0
+		}
+		result[field] = count
+	}
+	return result, nil
+}
+
+// MarkImageViewStatsApplied 將統計已寫入的欄位改名為已標記的欄位，重試時只會再累加 images.count，
+// 不會重複累加統計。回傳標記後的欄位名稱。
+func MarkImageViewStatsApplied(ctx context.Context, key string, counts map[string]int64) ([]string, error) {
+	if len(counts) == 0 {
+		return nil, nil
+	}
+	clt, err := client()
+	if err != nil {
+		return nil, err
+	}
+	marked := make([]string, 0, len(counts))
+	_, err = clt.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for field, count := range counts {
+			pipe.HDel(ctx, key, field)
+			pipe.HIncrBy(ctx, key, viewStatsAppliedPrefix+field, count)
+			marked = append(marked, viewStatsAppliedPrefix+field)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, wrapErr(ErrQueryFailed, err)
+	}
+	return marked, nil
+}
+
+// AckImageViewCounts 從 draining hash 移除已寫入資料庫的欄位；hash 清空後 Redis 會自動刪除該 key。
+func AckImageViewCounts(ctx context.Context, key string, fields ...string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	clt, err := client()
+	if err != nil {
+		return err
+	}
+	if err := clt.HDel(ctx, key, fields...).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		return wrapErr(ErrQueryFailed, err)
+	}
+	return nil
+}
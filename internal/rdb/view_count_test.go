@@ -0,0 +1,73 @@
+package rdb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageViewField(t *testing.T) {
+	viewedAt := time.Date(2025, 8, 20, 13, 45, 10, 0, time.FixedZone("UTC+8", 8*60*60))
+	field := ImageViewField("e2f9e558-5364-4465-9329-ea1b5f185900", viewedAt)
+	assert.Equal(t, "e2f9e558-5364-4465-9329-ea1b5f185900@1755666000", field)
+
+	id, hour := ParseImageViewField(field)
+	assert.Equal(t, "e2f9e558-5364-4465-9329-ea1b5f185900", id)
+	assert.Equal(t, time.Date(2025, 8, 20, 5, 0, 0, 0, time.UTC), hour)
+}
+
+func TestParseImageViewField(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    string
+		wantId   string
+		wantZero bool
+	}{
+		{
+			name:     "Legacy field without hour",
+			field:    "e2f9e558-5364-4465-9329-ea1b5f185900",
+			wantId:   "e2f9e558-5364-4465-9329-ea1b5f185900",
+			wantZero: true,
+		},
+		{
+			name:     "Invalid hour",
+			field:    "e2f9e558-5364-4465-9329-ea1b5f185900@abc",
+			wantId:   "e2f9e558-5364-4465-9329-ea1b5f185900@abc",
+			wantZero: true,
+		},
+		{
+			name:   "Valid field",
+			field:  "abc@3600",
+			wantId: "abc",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, hour := ParseImageViewField(tt.field)
+			assert.Equal(t, tt.wantId, id)
+			assert.Equal(t, tt.wantZero, hour.IsZero())
+		})
+	}
+}
+
+func TestSetKeyPrefix(t *testing.T) {
+	defer SetKeyPrefix("media")
+
+	SetKeyPrefix("")
+	assert.Equal(t, "{media:image:view}:pending", viewKey(viewPendingSuffix))
+
+	SetKeyPrefix("staging:media:")
+	assert.Equal(t, "{staging:media:image:view}:pending", viewKey(viewPendingSuffix))
+}
+
+func TestSplitStatsApplied(t *testing.T) {
+	field, applied := SplitStatsApplied("abc@3600")
+	assert.Equal(t, "abc@3600", field)
+	assert.False(t, applied)
+
+	field, applied = SplitStatsApplied(viewStatsAppliedPrefix + "abc@3600")
+	assert.Equal(t, "abc@3600", field)
+	assert.True(t, applied)
+}
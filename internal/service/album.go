@@ -0,0 +1,309 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/album"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/ezgrpc"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultAlbumListLimit = 20
+	defaultAlbumMaxImages = 1000
+)
+
+// albumServer 實作了 album.AlbumServiceServer gRPC 服務。
+type albumServer struct {
+	album.UnimplementedAlbumServiceServer
+}
+
+func init() {
+	// 將 albumServer 注入到 ezgrpc 中，與 imageServer 共用同一個 gRPC 伺服器。
+	ezgrpc.InjectGrpcService(func(s grpc.ServiceRegistrar) {
+		album.RegisterAlbumServiceServer(withInterceptors(s), &albumServer{})
+	})
+	// 註冊 gRPC-Gateway 處理程序，將 HTTP 請求代理到 gRPC 服務。
+	ezgrpc.RegisterHandlerFromEndpoint(album.RegisterAlbumServiceHandlerFromEndpoint)
+}
+
+// albumMaxImages 回傳單一相簿可容納的圖片數量，可由 album.max_images 設定。
+func albumMaxImages() int {
+	if n := viper.GetInt("album.max_images"); n > 0 {
+		return n
+	}
+	return defaultAlbumMaxImages
+}
+
+// CreateAlbum 建立相簿，建立者成為相簿的擁有者。
+func (s *albumServer) CreateAlbum(ctx context.Context, req *album.CreateAlbumRequest) (*album.Album, error) {
+	// 1. 取得登入的使用者
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 確認使用者可以編輯要加入的圖片
+	if len(req.GetImageIds()) > 0 {
+		err = requireImagesPermission(ctx, userId, req.GetImageIds(), db.PermissionEditor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// 3. 儲存相簿
+	a := db.NewAlbum(
+		db.WithAlbumOwner(userId),
+		db.WithAlbumName(req.GetName()),
+		db.WithAlbumDescription(req.GetDescription()),
+		db.WithAlbumImages(req.GetImageIds()),
+	)
+	_, err = mgo.Save(ctx, a)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	// 4. 在關係庫中記錄相簿擁有者
+	err = db.SaveAlbumUserOwner(ctx, userId, a.ID.Hex())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 5. 讓相簿的 viewer 可以檢視相簿中的圖片
+	err = db.LinkAlbumImages(ctx, a.ID.Hex(), a.ImageIDs...)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return a.ToProto(), nil
+}
+
+// GetAlbum 取得相簿，需要 viewer 權限；擁有者以外的使用者只會看到可以提供的圖片。
+func (s *albumServer) GetAlbum(ctx context.Context, req *album.AlbumRequest) (*album.Album, error) {
+	userId, err := requireAlbumPermission(ctx, req.GetAlbumId(), db.PermissionViewer)
+	if err != nil {
+		return nil, err
+	}
+	a, err := db.FindAlbum(ctx, req.GetAlbumId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	resp := a.ToProto()
+	if userId == a.OwnerID {
+		return resp, nil
+	}
+	resp.ImageIds, err = deliverableImageIds(ctx, a.ImageIDs)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(resp.ImageIds, resp.CoverImageId) {
+		resp.CoverImageId = ""
+		if len(resp.ImageIds) > 0 {
+			resp.CoverImageId = resp.ImageIds[0]
+		}
+	}
+	return resp, nil
+}
+
+// deliverableImageIds 依原本的順序回傳可以提供給請求者的圖片，駁回、暫緩、下架或被隱藏的圖片會被略過。
+func deliverableImageIds(ctx context.Context, imageIds []string) ([]string, error) {
+	result := make([]string, 0, len(imageIds))
+	for _, id := range imageIds {
+		d, err := imageDeliveryOf(ctx, id)
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if deliveryError(ctx, id, d) == nil {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+// ListAlbums 列出目前使用者擁有的相簿。
+func (s *albumServer) ListAlbums(ctx context.Context, req *album.ListAlbumsRequest) (*album.ListAlbumsResponse, error) {
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultAlbumListLimit
+	}
+	queryCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	albums, err := db.ListAlbumsByOwner(queryCtx, userId, int64(req.GetOffset()), limit)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	resp := &album.ListAlbumsResponse{
+		Albums: make([]*album.Album, 0, len(albums)),
+	}
+	for _, a := range albums {
+		resp.Albums = append(resp.Albums, a.ToProto())
+	}
+	return resp, nil
+}
+
+// RenameAlbum 更新相簿名稱與描述，需要 editor 權限。
+func (s *albumServer) RenameAlbum(ctx context.Context, req *album.RenameAlbumRequest) (*album.Album, error) {
+	_, err := requireAlbumPermission(ctx, req.GetAlbumId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	a, err := db.RenameAlbum(ctx, req.GetAlbumId(), req.GetName(), req.GetDescription())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return a.ToProto(), nil
+}
+
+// DeleteAlbum 刪除相簿與相簿的關係，相簿中的圖片不會被刪除；只有擁有者可以刪除。
+// 先移除圖片繼承的權限再刪除相簿，最後才刪除相簿的關係，任一步驟失敗時都可以重試；
+// 相簿已被刪除但關係尚未刪除時，重試只會刪除剩下的關係。
+func (s *albumServer) DeleteAlbum(ctx context.Context, req *album.AlbumRequest) (*album.DeleteAlbumResponse, error) {
+	// 1. 確認使用者是相簿擁有者
+	_, err := requireAlbumPermission(ctx, req.GetAlbumId(), db.PermissionOwner)
+	if err != nil {
+		return nil, err
+	}
+	a, err := db.FindAlbum(ctx, req.GetAlbumId())
+	if err != nil && !errors.Is(err, db.ErrAlbumNotFound) {
+		return nil, db.ToStatus(err).Err()
+	}
+	if a != nil {
+		// 2. 移除圖片從相簿繼承的權限
+		err = db.UnlinkAlbumImages(ctx, req.GetAlbumId(), a.ImageIDs...)
+		if err != nil {
+			return nil, db.ToStatus(err).Err()
+		}
+		// 3. 刪除相簿
+		err = db.DeleteAlbum(ctx, req.GetAlbumId())
+		if err != nil && !errors.Is(err, db.ErrAlbumNotFound) {
+			return nil, db.ToStatus(err).Err()
+		}
+		invalidateDeliveryCache(ctx, a.ImageIDs...)
+	}
+	// 4. 刪除相簿的關係，相簿的浮水印不再套用到這些圖片
+	err = db.DeleteAlbumRelation(ctx, req.GetAlbumId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return &album.DeleteAlbumResponse{
+		Message: "Album deleted successfully",
+	}, nil
+}
+
+// AddAlbumImages 將圖片加到相簿最後，需要相簿與圖片的 editor 權限。
+func (s *albumServer) AddAlbumImages(ctx context.Context, req *album.AlbumImagesRequest) (*album.Album, error) {
+	// 1. 確認使用者可以編輯相簿與圖片
+	userId, err := requireAlbumPermission(ctx, req.GetAlbumId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	err = requireImagesPermission(ctx, userId, req.GetImageIds(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 確認加入後不會超過相簿容量
+	a, err := db.FindAlbum(ctx, req.GetAlbumId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	added := 0
+	for _, id := range req.GetImageIds() {
+		if !a.HasImage(id) {
+			added++
+		}
+	}
+	if max := albumMaxImages(); len(a.ImageIDs)+added > max {
+		return nil, status.Errorf(codes.FailedPrecondition, "album can contain at most %d images", max)
+	}
+	// 3. 加入圖片
+	a, err = db.AddAlbumImages(ctx, req.GetAlbumId(), req.GetImageIds())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 4. 讓相簿的 viewer 可以檢視新加入的圖片，並套用相簿的浮水印
+	err = db.LinkAlbumImages(ctx, req.GetAlbumId(), req.GetImageIds()...)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	invalidateDeliveryCache(ctx, req.GetImageIds()...)
+	return a.ToProto(), nil
+}
+
+// RemoveAlbumImages 從相簿移除圖片，需要相簿的 editor 權限。
+func (s *albumServer) RemoveAlbumImages(ctx context.Context, req *album.AlbumImagesRequest) (*album.Album, error) {
+	// 1. 確認使用者可以編輯相簿
+	_, err := requireAlbumPermission(ctx, req.GetAlbumId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 移除圖片
+	a, err := db.RemoveAlbumImages(ctx, req.GetAlbumId(), req.GetImageIds())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 3. 移除圖片從相簿繼承的權限，相簿的浮水印不再套用到這些圖片
+	err = db.UnlinkAlbumImages(ctx, req.GetAlbumId(), req.GetImageIds()...)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	invalidateDeliveryCache(ctx, req.GetImageIds()...)
+	return a.ToProto(), nil
+}
+
+// ReorderAlbumImages 以新的順序排列相簿圖片，image_ids 必須剛好是相簿中的所有圖片。
+func (s *albumServer) ReorderAlbumImages(ctx context.Context, req *album.ReorderAlbumImagesRequest) (*album.Album, error) {
+	// 1. 確認使用者可以編輯相簿
+	_, err := requireAlbumPermission(ctx, req.GetAlbumId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 確認新的順序包含相簿中所有圖片
+	a, err := db.FindAlbum(ctx, req.GetAlbumId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	if !db.IsSamePermutation(a.ImageIDs, req.GetImageIds()) {
+		return nil, status.Error(codes.InvalidArgument, "image_ids must contain every image of the album exactly once")
+	}
+	// 3. 更新順序，期間相簿被修改時回傳 Aborted 讓客戶端重新讀取
+	a, err = db.ReorderAlbumImages(ctx, req.GetAlbumId(), a.ImageIDs, req.GetImageIds())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return a.ToProto(), nil
+}
+
+// SetAlbumCover 設定相簿封面，圖片必須在相簿中。
+func (s *albumServer) SetAlbumCover(ctx context.Context, req *album.SetAlbumCoverRequest) (*album.Album, error) {
+	// 1. 確認使用者可以編輯相簿
+	_, err := requireAlbumPermission(ctx, req.GetAlbumId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 確認圖片在相簿中
+	a, err := db.FindAlbum(ctx, req.GetAlbumId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	if !a.HasImage(req.GetImageId()) {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("image %s is not in album", req.GetImageId()))
+	}
+	// 3. 設定封面
+	a, err = db.SetAlbumCover(ctx, req.GetAlbumId(), req.GetImageId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return a.ToProto(), nil
+}
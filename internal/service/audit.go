@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/audit"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/ezgrpc"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultAuditListLimit = 20
+
+// auditServer 實作了 audit.AuditServiceServer gRPC 服務，提供管理員查詢稽核紀錄。
+type auditServer struct {
+	audit.UnimplementedAuditServiceServer
+}
+
+func init() {
+	// 將 auditServer 注入到 ezgrpc 中，與 imageServer 共用同一個 gRPC 伺服器。
+	ezgrpc.InjectGrpcService(func(s grpc.ServiceRegistrar) {
+		audit.RegisterAuditServiceServer(withInterceptors(s), &auditServer{})
+	})
+	// 註冊 gRPC-Gateway 處理程序，將 HTTP 請求代理到 gRPC 服務。
+	ezgrpc.RegisterHandlerFromEndpoint(audit.RegisterAuditServiceHandlerFromEndpoint)
+}
+
+// parseAuditTime 解析 RFC3339 格式的時間，空字串表示不限。
+func parseAuditTime(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid %s: %s", field, value)
+	}
+	return t, nil
+}
+
+// QueryAuditLog 依操作者、動作、資源 ID、結果與時間範圍查詢稽核紀錄，需要管理員權限。
+func (s *auditServer) QueryAuditLog(ctx context.Context, req *audit.QueryAuditLogRequest) (*audit.QueryAuditLogResponse, error) {
+	// 1. 確認使用者是管理員
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 解析查詢條件
+	since, err := parseAuditTime("since", req.GetSince())
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseAuditTime("until", req.GetUntil())
+	if err != nil {
+		return nil, err
+	}
+	if !since.IsZero() && !until.IsZero() && !since.Before(until) {
+		return nil, status.Error(codes.InvalidArgument, "since must be before until")
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultAuditListLimit
+	}
+	filter := db.AuditLogFilter{
+		ActorID:   req.GetActorId(),
+		Action:    req.GetAction(),
+		TargetID:  req.GetTargetId(),
+		Outcome:   req.GetOutcome(),
+		RequestID: req.GetRequestId(),
+		Since:     since,
+		Until:     until,
+	}
+	// 3. 查詢稽核紀錄
+	queryCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	logs, err := db.QueryAuditLogs(queryCtx, filter, int64(req.GetOffset()), limit)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	resp := &audit.QueryAuditLogResponse{
+		Logs: make([]*audit.AuditLog, 0, len(logs)),
+	}
+	for _, l := range logs {
+		resp.Logs = append(resp.Logs, l.ToProto())
+	}
+	return resp, nil
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arwoosa/media/internal/cloudflare"
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/imagehash"
+	"github.com/arwoosa/media/internal/pb/blocklist"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/ezgrpc"
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultBlocklistListLimit     = 20
+	defaultBlocklistPHashDistance = 6
+
+	// blocklistMaxBytes 是下載原圖比對封鎖清單的上限，與 Cloudflare Images 的上傳上限相同。
+	blocklistMaxBytes = 10485760
+)
+
+// blocklistServer 實作了 blocklist.BlocklistServiceServer gRPC 服務，管理禁止上傳的圖片雜湊。
+type blocklistServer struct {
+	blocklist.UnimplementedBlocklistServiceServer
+}
+
+func init() {
+	// 將 blocklistServer 注入到 ezgrpc 中，與 imageServer 共用同一個 gRPC 伺服器。
+	ezgrpc.InjectGrpcService(func(s grpc.ServiceRegistrar) {
+		blocklist.RegisterBlocklistServiceServer(withInterceptors(s), &blocklistServer{})
+	})
+	// 註冊 gRPC-Gateway 處理程序，將 HTTP 請求代理到 gRPC 服務。
+	ezgrpc.RegisterHandlerFromEndpoint(blocklist.RegisterBlocklistServiceHandlerFromEndpoint)
+}
+
+// blocklistEnabled 回傳上傳時是否比對封鎖清單，可由 blocklist.enabled 關閉。
+func blocklistEnabled() bool {
+	if viper.IsSet("blocklist.enabled") {
+		return viper.GetBool("blocklist.enabled")
+	}
+	return true
+}
+
+// blocklistPHashDistance 回傳感知雜湊視為相同圖片的最大漢明距離，可由 blocklist.phash_distance 設定。
+func blocklistPHashDistance() int {
+	if viper.IsSet("blocklist.phash_distance") {
+		return viper.GetInt("blocklist.phash_distance")
+	}
+	return defaultBlocklistPHashDistance
+}
+
+// matchBlocklist 下載圖片原檔，以 SHA-256 與感知雜湊比對封鎖清單；無法解碼的格式只比對 SHA-256。
+func matchBlocklist(ctx context.Context, imageId string, phashes *db.BlockedPHashes) (*db.BlocklistMatch, error) {
+	data, err := cloudflare.GetImageBlob(ctx, imageId, blocklistMaxBytes)
+	if err != nil {
+		return nil, cloudflare.ToStatus(err).Err()
+	}
+	var phash *uint64
+	if h, err := imagehash.PHash(data); err == nil {
+		phash = &h
+	}
+	match, err := db.MatchBlockedHash(ctx, imagehash.SHA256(data), phash, phashes, blocklistPHashDistance())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return match, nil
+}
+
+// findBlockedUploads 比對圖片是否命中封鎖清單，回傳命中的圖片並為每張記錄稽核紀錄。
+// 感知雜湊清單在比對前載入一次，由所有圖片共用。
+func findBlockedUploads(ctx context.Context, uploaderId string, imageIds []string) ([]string, error) {
+	if !blocklistEnabled() || len(imageIds) == 0 {
+		return nil, nil
+	}
+	// 1. 封鎖清單為空時不需要下載原圖
+	ok, err := db.HasBlockedHashes(ctx)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	if !ok {
+		return nil, nil
+	}
+	phashes, err := db.LoadBlockedPHashes(ctx)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	// 2. 逐張比對並記錄命中的圖片
+	var blocked []string
+	for _, id := range imageIds {
+		match, err := matchBlocklist(ctx, id, phashes)
+		if err != nil {
+			return nil, err
+		}
+		if match == nil {
+			continue
+		}
+		blocked = append(blocked, id)
+		err = db.SaveAuditLog(ctx,
+			db.WithAuditAction(db.AuditBlocklistMatch),
+			db.WithAuditActor(uploaderId),
+			db.WithAuditResource("image", id),
+			db.WithAuditDetail(map[string]string{
+				"hash_id":  match.HashID,
+				"type":     match.Type,
+				"hash":     match.Hash,
+				"reason":   match.Reason,
+				"distance": strconv.Itoa(match.Distance),
+			}))
+		if err != nil {
+			log.Warn("failed to write blocklist audit log", log.String("image_id", id), log.Err(err))
+		}
+	}
+	return blocked, nil
+}
+
+// blockedContentError 回傳上傳命中封鎖清單時的 BLOCKED_CONTENT 錯誤。
+func blockedContentError(blocked []string) error {
+	return errorWithCode(codes.PermissionDenied, image.ErrorCode_BLOCKED_CONTENT,
+		"Upload matches prohibited content", map[string]string{"image_ids": strings.Join(blocked, ",")})
+}
+
+// rejectBlockedUploads 在圖片上線前比對封鎖清單。有圖片命中時刪除這次上傳的所有圖片與會話，
+// 並回傳 BLOCKED_CONTENT。
+func rejectBlockedUploads(ctx context.Context, uploaderId string, imageIds []string) error {
+	blocked, err := findBlockedUploads(ctx, uploaderId, imageIds)
+	if err != nil || len(blocked) == 0 {
+		return err
+	}
+	// 刪除這次上傳的所有圖片與會話，避免留下未完成的上傳
+	if err := cloudflare.DeleteImages(ctx, imageIds...); err != nil {
+		log.Warn("failed to delete blocked uploads", log.String("image_ids", strings.Join(imageIds, ",")), log.Err(err))
+	}
+	if err := ezgrpc.DeleteSession(ctx); err != nil {
+		log.Warn("failed to delete upload session", log.Err(err))
+	}
+	return blockedContentError(blocked)
+}
+
+// rejectBlockedReplacement 在更換的內容上線前比對封鎖清單。命中時刪除新上傳的內容並清除尚未完成的更換，
+// 圖片維持原本的版本，並回傳 BLOCKED_CONTENT。
+func rejectBlockedReplacement(ctx context.Context, uploaderId, imageId, providerId string) error {
+	blocked, err := findBlockedUploads(ctx, uploaderId, []string{providerId})
+	if err != nil || len(blocked) == 0 {
+		return err
+	}
+	if err := cloudflare.DeleteImages(ctx, providerId); err != nil {
+		log.Warn("failed to delete blocked replacement", log.String("provider_id", providerId), log.Err(err))
+	}
+	if err := db.ClearImageReplacement(ctx, imageId, providerId); err != nil {
+		log.Warn("failed to clear blocked replacement", log.String("image_id", imageId), log.Err(err))
+	}
+	return blockedContentError([]string{imageId})
+}
+
+// AddBlockedHash 新增封鎖的雜湊並記錄稽核紀錄。
+func (s *blocklistServer) AddBlockedHash(ctx context.Context, req *blocklist.AddBlockedHashRequest) (*blocklist.BlockedHash, error) {
+	// 1. 確認使用者是管理員，並檢查雜湊格式
+	adminId, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := imagehash.Normalize(req.GetType(), req.GetHash())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// 2. 保存雜湊
+	b := db.NewBlockedHash(
+		db.WithBlockedHash(req.GetType(), hash),
+		db.WithBlockedHashReason(req.GetReason(), req.GetSource()),
+		db.WithBlockedHashCreator(adminId))
+	err = db.SaveBlockedHash(ctx, b)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 3. 記錄稽核紀錄
+	err = db.SaveAuditLog(ctx,
+		db.WithAuditAction(db.AuditBlocklistAdd),
+		db.WithAuditActor(adminId),
+		db.WithAuditResource("blocked_hash", b.ID.Hex()),
+		db.WithAuditDetail(map[string]string{"type": b.Type, "hash": b.Hash, "reason": b.Reason, "source": b.Source}))
+	if err != nil {
+		log.Warn("failed to write blocklist audit log", log.String("hash_id", b.ID.Hex()), log.Err(err))
+	}
+	return b.ToProto(), nil
+}
+
+// ListBlockedHashes 依新增時間由新到舊列出封鎖的雜湊。
+func (s *blocklistServer) ListBlockedHashes(ctx context.Context, req *blocklist.ListBlockedHashesRequest) (*blocklist.ListBlockedHashesResponse, error) {
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultBlocklistListLimit
+	}
+	queryCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	hashes, err := db.ListBlockedHashes(queryCtx, req.GetType(), int64(req.GetOffset()), limit)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	resp := &blocklist.ListBlockedHashesResponse{
+		Hashes: make([]*blocklist.BlockedHash, 0, len(hashes)),
+	}
+	for _, b := range hashes {
+		resp.Hashes = append(resp.Hashes, b.ToProto())
+	}
+	return resp, nil
+}
+
+// DeleteBlockedHash 刪除封鎖的雜湊並記錄稽核紀錄。
+func (s *blocklistServer) DeleteBlockedHash(ctx context.Context, req *blocklist.DeleteBlockedHashRequest) (*blocklist.DeleteBlockedHashResponse, error) {
+	adminId, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b, err := db.DeleteBlockedHash(ctx, req.GetHashId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	err = db.SaveAuditLog(ctx,
+		db.WithAuditAction(db.AuditBlocklistDelete),
+		db.WithAuditActor(adminId),
+		db.WithAuditResource("blocked_hash", b.ID.Hex()),
+		db.WithAuditDetail(map[string]string{"type": b.Type, "hash": b.Hash}))
+	if err != nil {
+		log.Warn("failed to write blocklist audit log", log.String("hash_id", b.ID.Hex()), log.Err(err))
+	}
+	return &blocklist.DeleteBlockedHashResponse{
+		Message: "Blocked hash deleted successfully",
+	}, nil
+}
@@ -0,0 +1,19 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlocklistConfig(t *testing.T) {
+	defer viper.Reset()
+	assert.True(t, blocklistEnabled())
+	assert.Equal(t, defaultBlocklistPHashDistance, blocklistPHashDistance())
+
+	viper.Set("blocklist.enabled", false)
+	viper.Set("blocklist.phash_distance", 0)
+	assert.False(t, blocklistEnabled())
+	assert.Equal(t, 0, blocklistPHashDistance())
+}
@@ -1,13 +1,32 @@
 package service
 
 import (
+	"github.com/arwoosa/media/internal/pb/image"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const errorDomain = "media"
+
 func errorWrappesr(err error) error {
 	if err == nil {
 		return nil
 	}
 	return status.Error(codes.Internal, err.Error())
 }
+
+// errorWithCode 回傳附帶 ErrorInfo 的 gRPC 錯誤，Reason 為 image.ErrorCode 的名稱，讓客戶端可以依錯誤代碼處理。
+func errorWithCode(code codes.Code, errCode image.ErrorCode, msg string, metadata map[string]string) error {
+	st := status.New(code, msg)
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   errCode.String(),
+		Domain:   errorDomain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
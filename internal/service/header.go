@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/arwoosa/media/internal/interceptor"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	keyUserRole = "user-role"
+	keyAccept   = "accept"
+)
+
+// headerTransMap 包含 ezgrpc 轉送的使用者標頭，以及 media 服務額外需要的標頭。
+// gRPC-Gateway 只會使用最後一個 IncomingHeaderMatcher，因此 ezgrpc 的對應也必須列在這裡。
+var headerTransMap = map[string]string{
+	"x-user-id":       "user-id",
+	"x-user-account":  "user-account",
+	"x-user-email":    "user-email",
+	"x-user-name":     "user-name",
+	"x-user-language": "user-language",
+	"x-user-role":     keyUserRole,
+	"accept":          keyAccept,
+	"x-request-id":    interceptor.HeaderRequestID,
+}
+
+// IncomingHeaderMatcher 取代 ezgrpc.DefaultHeaderMatcher，將 HTTP 標頭轉送到 gRPC metadata。
+var IncomingHeaderMatcher = runtime.WithIncomingHeaderMatcher(func(k string) (string, bool) {
+	if v, ok := headerTransMap[strings.ToLower(k)]; ok {
+		return v, true
+	}
+	return runtime.DefaultHeaderMatcher(k)
+})
+
+// getIncomingHeader 從 gRPC metadata 取得第一個值，不存在時回傳空字串。
+func getIncomingHeader(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// userRole 回傳上游認證服務透過 x-user-role 標頭傳入的角色。
+func userRole(ctx context.Context) string {
+	return strings.ToLower(getIncomingHeader(ctx, keyUserRole))
+}
+
+// setResponseHeader 設定回應標頭，經由 gRPC-Gateway 轉成 HTTP 標頭。
+func setResponseHeader(ctx context.Context, key, value string) error {
+	return grpc.SetHeader(ctx, metadata.Pairs(key, value))
+}
@@ -5,14 +5,18 @@ import (
 	"errors"
 	"time"
 
+	"github.com/arwoosa/media/internal/classifier"
 	"github.com/arwoosa/media/internal/cloudflare"
 	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/interceptor"
 	"github.com/arwoosa/media/internal/pb/image"
-	"github.com/arwoosa/vulpes/db/cache"
+	"github.com/arwoosa/media/internal/rdb"
 	"github.com/arwoosa/vulpes/db/mgo"
 	"github.com/arwoosa/vulpes/ezgrpc"
+	"github.com/arwoosa/vulpes/log"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/viper"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo"
 	"google.golang.org/grpc"
@@ -30,7 +34,7 @@ var ()
 func init() {
 	// 將 imageServer 注入到 ezgrpc 中，以便 gRPC 伺服器可以註冊它。
 	ezgrpc.InjectGrpcService(func(s grpc.ServiceRegistrar) {
-		image.RegisterImageServiceServer(s, &imageServer{})
+		image.RegisterImageServiceServer(withInterceptors(s), &imageServer{})
 	})
 	// 註冊 gRPC-Gateway 處理程序，將 HTTP 請求代理到 gRPC 服務。
 	ezgrpc.RegisterHandlerFromEndpoint(image.RegisterImageServiceHandlerFromEndpoint)
@@ -64,12 +68,18 @@ func (s *imageServer) BatchUpload(ctx context.Context, req *image.UploadRequest)
 		}, nil
 	}
 
-	// 2. 為每張圖片生成預簽名的 URL。
+	// 2. 檢查使用者的儲存配額，並在完成上傳前預留這批圖片的配額。
+	reservation, err := reserveStorageQuota(ctx, req.Images)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 為每張圖片生成預簽名的 URL。
 	uploadImages := make(signedUrlSlice, len(req.Images))
 	ctx, cancel := context.WithTimeout(ctx, time.Second*3)
 	defer cancel()
 	for i := range req.Images {
-		// 2.1. 獲取預簽名的 URL
+		// 3.1. 獲取預簽名的 URL
 		signedUrl, err := cloudflare.GetSignedUrl(ctx,
 			cloudflare.ImageMetadataSize(req.Images[i].Size),
 			cloudflare.ImageMetadataWidth(req.Images[i].Width),
@@ -78,21 +88,33 @@ func (s *imageServer) BatchUpload(ctx context.Context, req *image.UploadRequest)
 			cloudflare.ImageMetadataLatitude(req.Images[i].Latitude),
 			cloudflare.ImageMetadataLongitude(req.Images[i].Longitude))
 		if err != nil {
+			releaseStorageReservation(ctx, reservation)
 			return nil, cloudflare.ToStatus(err).Err()
 		}
 		uploadImages[i] = &image.SignedUrl{
 			ImageId:   signedUrl.ID,
 			SignedUrl: signedUrl.UploadURL,
+			Info:      req.Images[i].Info,
 		}
 	}
 
-	// 3. 將生成的 URL 數據設置到會話中。
+	// 4. 將生成的 URL 數據設置到會話中，並記錄到稽核紀錄。
+	interceptor.AuditTarget(ctx, uploadImages.GetImageIds()...)
 	err = ezgrpc.SetSessionData(ctx, uploadImages)
 	if err != nil {
+		releaseStorageReservation(ctx, reservation)
 		return nil, ezgrpc.ToStatus(err).Err()
 	}
 
-	// 4. 在響應中返回 URL。
+	// 4.1. 記錄預留對應的圖片，完成上傳或清除會話時以圖片 ID 釋放
+	if reservation != nil {
+		err = db.SetStorageReservationImages(ctx, reservation.ID, uploadImages.GetImageIds())
+		if err != nil {
+			return nil, mgo.ToStatus(err).Err()
+		}
+	}
+
+	// 5. 在響應中返回 URL。
 	return &image.UploadResponse{
 		Images: uploadImages,
 	}, nil
@@ -108,12 +130,39 @@ func (s *imageServer) Complete(ctx context.Context, req *image.StatusRequest) (*
 		return nil, ezgrpc.ToStatus(err).Err()
 	}
 	imageIds := data.GetImageIds()
+	interceptor.AuditTarget(ctx, imageIds...)
+	infos := make(map[string]*image.ImageInfo, len(data))
+	for _, v := range data {
+		infos[v.ImageId] = v.Info
+	}
+
+	// 2. 取得上傳者，作為圖片的擁有者。
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, ezgrpc.ToStatus(err).Err()
+	}
+	ownerId := ""
+	if user != nil {
+		ownerId = user.ID
+	}
 
-	// 2. 查詢 Cloudflare 以獲取圖片的詳細信息。
+	// 3. 比對封鎖清單，命中時拒絕這次上傳
+	err = rejectBlockedUploads(ctx, ownerId, imageIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. 查詢 Cloudflare 以獲取圖片的詳細信息，並在上線前進行自動分類。
+	contentClassifier, err := classifier.FromViper()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 	completeCtx, cancel := context.WithTimeout(ctx, time.Second*2)
 	defer cancel()
 	images := cloudflare.GetImages(completeCtx, imageIds)
 	result := make([]*image.ImageStatus, len(imageIds))
+	usageDeltas := make([]db.StorageDelta, len(imageIds))
+	events := make([]*db.OutboxEvent, len(imageIds))
 	bulk, err := mgo.NewBulkOperation(db.NewImage().C())
 	if err != nil {
 		return nil, mgo.ToStatus(err).Err()
@@ -121,6 +170,12 @@ func (s *imageServer) Complete(ctx context.Context, req *image.StatusRequest) (*
 
 	for i, id := range imageIds {
 		saveImage := images[id].Image
+		moderationStatus, labels := classifyUpload(ctx, contentClassifier, classifier.Image{
+			ID:       id,
+			Filename: saveImage.Filename,
+			Meta:     saveImage.Meta,
+			URL:      originalVariantURL(saveImage.Variants),
+		})
 		myImage := db.NewImage(
 			db.WithImageCloudflareID(id),
 			db.WithImageFilename(saveImage.Filename),
@@ -130,8 +185,14 @@ func (s *imageServer) Complete(ctx context.Context, req *image.StatusRequest) (*
 			db.WithImageCount(0),
 			db.WithSize(saveImage.GetSize()),
 			db.WithLocation(saveImage.GetLongitude(), saveImage.GetLatitude()),
+			db.WithImageOwner(ownerId),
+			db.WithImageInfo(toDbImageInfo(infos[id])),
+			db.WithImageModeration(moderationStatus, labels...),
+			db.WithImageUsageCounted(),
 		)
 		bulk.InsertOne(myImage)
+		usageDeltas[i] = db.StorageDeltaOf(myImage, 1)
+		events[i] = db.NewImageEvent(db.EventImageUploaded, myImage, nil)
 		result[i] = &image.ImageStatus{
 			ImageId: id,
 			Metadata: &image.ImageMetadata{
@@ -141,49 +202,70 @@ func (s *imageServer) Complete(ctx context.Context, req *image.StatusRequest) (*
 				Size:       saveImage.GetSize(),
 				UploadTime: saveImage.Uploaded.Format(time.RFC3339),
 			},
-			Variants: myImage.Variants,
+			Variants:         signVariants(myImage.Variants),
+			Info:             toPbImageInfo(myImage.ImageInfo),
+			ModerationStatus: moderationStatus,
+		}
+		// 暫緩的圖片在審核前不提供，上傳者也不會取得變體 URL
+		if moderationError(id, moderationStatus) != nil {
+			result[i].Variants = nil
 		}
 	}
 
-	// 3. 存入資料庫
-
-	_, err = bulk.Execute(ctx)
+	// 5. 存入資料庫並寫入上傳事件，同時將預留的配額轉為擁有者的儲存用量
+	err = db.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := bulk.Execute(ctx); err != nil {
+			return err
+		}
+		if err := db.SaveOutboxEvents(ctx, events...); err != nil {
+			return err
+		}
+		if err := db.IncStorageUsage(ctx, usageDeltas...); err != nil {
+			return err
+		}
+		return db.ReleaseStorageReservations(ctx, imageIds)
+	})
 	if err != nil {
 		return nil, mgo.ToStatus(err).Err()
 	}
 
-	// 4. 建立關係
-	user, err := ezgrpc.GetUser(ctx)
-	if err != nil {
-		return nil, ezgrpc.ToStatus(err).Err()
-	}
-	if user != nil {
-		err = db.SaveImageUserOwner(ctx, user.ID, imageIds)
+	// 6. 建立關係
+	if ownerId != "" {
+		err = db.SaveImageUserOwner(ctx, ownerId, imageIds)
 		if err != nil {
 			return nil, db.ToStatus(err).Err()
 		}
 	}
 
-	// 5. 刪除會話。
+	// 7. 刪除會話。
 	err = ezgrpc.DeleteSession(ctx)
 	if err != nil {
 		return nil, ezgrpc.ToStatus(err).Err()
 	}
 
-	// 6. 返回包含圖片狀態和元數據的響應。
+	// 8. 返回包含圖片狀態和元數據的響應。
 	return &image.StatusResponse{
 		Images: result,
 	}, nil
 }
 
-// Clear 清除預簽名 URL 的緩存。
+// Clear 清除預簽名 URL 的緩存，並釋放這些圖片預留的配額。
 func (s *imageServer) Clear(ctx context.Context, req *image.ClearRequest) (*image.ClearResponse, error) {
-	// 1. 清除給定命名空間的預簽名 URL 緩存。
-	err := ezgrpc.DeleteSession(ctx)
+	// 1. 釋放會話中圖片預留的配額
+	data, err := ezgrpc.GetSessionData[signedUrlSlice](ctx)
+	if err != nil && !errors.Is(err, ezgrpc.ErrSessionNotFound) {
+		return nil, ezgrpc.ToStatus(err).Err()
+	}
+	err = db.ReleaseStorageReservations(ctx, data.GetImageIds())
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	// 2. 清除給定命名空間的預簽名 URL 緩存。
+	err = ezgrpc.DeleteSession(ctx)
 	if err != nil {
 		return nil, ezgrpc.ToStatus(err).Err()
 	}
-	// 2. 返回成功響應。
+	// 3. 返回成功響應。
 	return &image.ClearResponse{
 		Message: "Cache cleared successfully",
 	}, nil
@@ -192,21 +274,11 @@ func (s *imageServer) Clear(ctx context.Context, req *image.ClearRequest) (*imag
 // Delete 刪除單張圖片。
 func (s *imageServer) Delete(ctx context.Context, req *image.DeleteRequest) (*image.DeleteResponse, error) {
 	// 1. 刪除圖片
-	err := cloudflare.DeleteImages(ctx, req.GetImageId())
-	if err != nil {
-		return nil, cloudflare.ToStatus(err).Err()
-	}
-	// 2. 刪除資料庫中的圖片
-	_, err = mgo.DeleteMany(ctx, db.NewImage(), bson.D{{Key: "cloudflare_id", Value: req.GetImageId()}})
-	if err != nil {
-		return nil, mgo.ToStatus(err).Err()
-	}
-	// 3. 刪除資料庫中的圖片關係
-	err = db.DeleteImageUserRelation(ctx, req.ImageId)
+	err := deleteImages(ctx, req.GetForce(), req.GetImageId())
 	if err != nil {
-		return nil, db.ToStatus(err).Err()
+		return nil, err
 	}
-	// 4. 返回成功響應。
+	// 2. 返回成功響應。
 	return &image.DeleteResponse{
 		Message: "Image deleted successfully",
 	}, nil
@@ -215,83 +287,176 @@ func (s *imageServer) Delete(ctx context.Context, req *image.DeleteRequest) (*im
 // BatchDelete 刪除多張圖片。
 func (s *imageServer) BatchDelete(ctx context.Context, req *image.BatchDeleteRequest) (*image.BatchDeleteResponse, error) {
 	// 1. 刪除圖片
-	err := cloudflare.DeleteImages(ctx, req.GetImageIds()...)
+	err := deleteImages(ctx, req.GetForce(), req.GetImageIds()...)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 返回成功響應。
+	return &image.BatchDeleteResponse{
+		Message: "Images deleted successfully",
+	}, nil
+}
+
+// deleteImages 是刪除圖片的共用流程，依序清除 Cloudflare、資料庫、相簿、關係、用量與排行榜。
+// 圖片仍被其他服務引用時拒絕刪除，除非 force 為 true。
+func deleteImages(ctx context.Context, force bool, imageIds ...string) error {
+	// 1. 查詢要刪除的圖片，用於檢查引用與扣除擁有者的儲存用量
+	images, err := db.FindImagesByCloudflareIDs(ctx, imageIds)
 	if err != nil {
-		return nil, cloudflare.ToStatus(err).Err()
+		return mgo.ToStatus(err).Err()
+	}
+	if !force {
+		for _, id := range imageIds {
+			if img, ok := images[id]; ok && len(img.References) > 0 {
+				return imageInUseError(id, img.References)
+			}
+		}
+	}
+	// 2. 刪除 Cloudflare 上的圖片，包含歷史版本與尚未完成的更換
+	providerIds := make([]string, 0, len(imageIds))
+	for _, id := range imageIds {
+		if img, ok := images[id]; ok {
+			providerIds = append(providerIds, img.ProviderIDs()...)
+			continue
+		}
+		providerIds = append(providerIds, id)
 	}
-	// 2. 刪除資料庫中的圖片
-	_, err = mgo.DeleteMany(ctx, db.NewImage(), bson.D{{Key: "cloudflare_id", Value: bson.M{"$in": req.GetImageIds()}}})
+	err = cloudflare.DeleteImages(ctx, providerIds...)
 	if err != nil {
-		return nil, mgo.ToStatus(err).Err()
+		return cloudflare.ToStatus(err).Err()
+	}
+	// 3. 刪除資料庫中的圖片並寫入刪除事件
+	events := make([]*db.OutboxEvent, 0, len(images))
+	for _, img := range images {
+		events = append(events, db.NewImageEvent(db.EventImageDeleted, img, nil))
 	}
-	// 3. 刪除資料庫中的圖片關係
-	err = db.DeleteImageUserRelation(ctx, req.ImageIds...)
+	err = db.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := mgo.DeleteMany(ctx, db.NewImage(), bson.D{{Key: "cloudflare_id", Value: bson.M{"$in": imageIds}}}); err != nil {
+			return err
+		}
+		return db.SaveOutboxEvents(ctx, events...)
+	})
 	if err != nil {
-		return nil, db.ToStatus(err).Err()
+		return mgo.ToStatus(err).Err()
 	}
-	// 4. 返回成功響應。
-	return &image.BatchDeleteResponse{
-		Message: "Images deleted successfully",
-	}, nil
+	// 4. 從所有相簿移除圖片
+	err = db.RemoveImagesFromAllAlbums(ctx, imageIds...)
+	if err != nil {
+		return mgo.ToStatus(err).Err()
+	}
+	// 5. 刪除資料庫中的圖片關係（包含從相簿繼承的權限）
+	err = db.DeleteImageUserRelation(ctx, imageIds...)
+	if err != nil {
+		return db.ToStatus(err).Err()
+	}
+	// 6. 扣除擁有者的儲存用量
+	usageDeltas := make([]db.StorageDelta, 0, len(images))
+	for _, img := range images {
+		usageDeltas = append(usageDeltas, db.StorageDeltaOf(img, -1))
+	}
+	err = db.IncStorageUsage(ctx, usageDeltas...)
+	if err != nil {
+		return mgo.ToStatus(err).Err()
+	}
+	// 7. 從排行榜移除
+	err = rdb.RemoveImageRank(ctx, imageIds...)
+	if err != nil {
+		return rdb.ToStatus(err).Err()
+	}
+	// 8. 清除變體快取
+	err = rdb.InvalidateImageVariants(ctx, imageIds...)
+	if err != nil {
+		return rdb.ToStatus(err).Err()
+	}
+	return nil
 }
 
-func (s *imageServer) GetImageURI(ctx context.Context, req *image.ImageRequest) (*image.ImageResponse, error) {
-	// 1. 從資料庫中取得圖片的 Cloudflare ID
+const defaultVariantCacheTTL = 10 * time.Minute
+
+// imageVariants 回傳圖片的變體 URL，快取未命中時從資料庫讀取並寫回快取；快取失敗不影響結果。
+func imageVariants(ctx context.Context, imageId string) (map[string]string, error) {
+	variants, ok, err := rdb.GetImageVariants(ctx, imageId)
+	if err != nil {
+		log.Warn("failed to read image variants cache", log.String("image_id", imageId), log.Err(err))
+	}
+	if ok {
+		return variants, nil
+	}
 	queryImg := db.NewImage()
 	queryCtx, cancel := context.WithTimeout(ctx, time.Second*2)
 	defer cancel()
-	err := mgo.FindOne(queryCtx, queryImg, bson.M{"cloudflare_id": req.GetId()})
+	err = mgo.FindOne(queryCtx, queryImg, bson.M{"cloudflare_id": imageId})
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, status.Error(codes.NotFound, "Image not found")
 		}
 		return nil, mgo.ToStatus(err).Err()
 	}
-	// 2. 取得圖片的變體
-	url, ok := queryImg.Variants[req.GetVariant()]
-	if !ok {
-		return nil, status.Error(codes.NotFound, "Variant not found")
+	err = rdb.SetImageVariants(ctx, imageId, queryImg.Variants, variantCacheTTL())
+	if err != nil {
+		log.Warn("failed to write image variants cache", log.String("image_id", imageId), log.Err(err))
+	}
+	return queryImg.Variants, nil
+}
+
+// variantCacheTTL 回傳變體快取的存活時間，可由 cache.variant_ttl 設定。
+func variantCacheTTL() time.Duration {
+	if d := viper.GetDuration("cache.variant_ttl"); d > 0 {
+		return d
+	}
+	return defaultVariantCacheTTL
+}
+
+func (s *imageServer) GetImageURI(ctx context.Context, req *image.ImageRequest) (*image.ImageResponse, error) {
+	// 1. 取得圖片的傳遞設定，駁回或下架的圖片不再提供，被隱藏的圖片只有擁有者能取得
+	delivery, err := imageDeliveryOf(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err := deliveryError(ctx, req.GetId(), delivery); err != nil {
+		return nil, err
+	}
+	// 2. 取得圖片的變體，優先使用 Redis 快取
+	variants, err := imageVariants(ctx, req.GetId())
+	if err != nil {
+		return nil, err
 	}
-	// 3. 設置重定向 URL
+	// 3. 取得具名變體或即時轉換的 URL，依 Accept 標頭選擇 AVIF 或 WebP，並疊加浮水印
+	url, formatApplied, err := resolveImageURI(ctx, req, variants, requestImageFormat(ctx))
+	if err != nil {
+		return nil, err
+	}
+	url = applyDeliveryWatermark(ctx, delivery, url)
+	// 4. 設置重定向 URL
 	ezgrpc.SetRedirectUrl(ctx, url)
-	// 4. 增加計數器
-	_, err = cache.Incr(ctx, queryImg.CloudflareID)
+	// 只有使用了協商出的格式時，回應才會因 Accept 標頭而不同
+	if formatApplied {
+		_ = setResponseHeader(ctx, "vary", "Accept")
+	}
+	// 5. 增加計數器與排行榜分數
+	err = rdb.IncrImageView(ctx, req.GetId())
 	if err != nil {
-		return nil, cache.ToStatus(err).Err()
+		return nil, rdb.ToStatus(err).Err()
 	}
-	// 5. 返回成功響應。
+	err = rdb.IncrImageRank(ctx, req.GetId(), trendingHalfLife(), trendingMaxSize())
+	if err != nil {
+		return nil, rdb.ToStatus(err).Err()
+	}
+	// 6. 返回成功響應。
 	return &image.ImageResponse{
 		Uri: url,
 	}, nil
 }
 
+// SyncImageCount 將 Redis 中累積的瀏覽次數同步到資料庫（Cron Job 使用）。
 func (s *imageServer) SyncImageCount(ctx context.Context, req *empty.Empty) (*empty.Empty, error) {
-	// random error
-	time.Sleep(time.Second * 2)
-
-	// if rand.New(rand.NewSource(time.Now().UnixNano())).Int()%2 == 0 {
-	// 	return nil, status.Error(codes.Internal, "Internal error")
-	// }
-	return &empty.Empty{}, nil
-	// 1. get all key from cache
-	err := cache.DeleteAfterScanExecuteInt(ctx, "*", func(key string, val int) error {
-		updateCtx, cancel := context.WithTimeout(ctx, time.Second*2)
-		defer cancel()
-		fields := bson.D{{Key: "count", Value: val}}
-		_, err := mgo.UpdateOne(
-			updateCtx, db.NewImage(),
-			bson.D{{Key: "cloudflare_id", Value: key}},
-			bson.D{{Key: "$inc", Value: fields}},
-		)
-		return err
-	})
-
+	_, err := FlushImageCount(ctx)
 	if err != nil {
 		switch {
 		case errors.Is(err, mgo.ErrWriteFailed):
 			return nil, mgo.ToStatus(err).Err()
 		default:
-			return nil, cache.ToStatus(err).Err()
+			return nil, rdb.ToStatus(err).Err()
 		}
 	}
 	return &empty.Empty{}, nil
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/arwoosa/media/internal/classifier"
+	"github.com/arwoosa/media/internal/cloudflare"
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultClassifierThreshold = 0.8
+
+	// labelClassifierError 是分類失敗而暫緩圖片時記錄的標籤。
+	labelClassifierError = "classifier:error"
+)
+
+// classifierThreshold 回傳標記圖片的分數門檻，可由 classifier.threshold 設定。
+func classifierThreshold() float64 {
+	if v := viper.GetFloat64("classifier.threshold"); v > 0 {
+		return v
+	}
+	return defaultClassifierThreshold
+}
+
+// classifyUpload 在圖片上線前進行自動分類，回傳審核狀態與標記的標籤。
+// 有標籤達到門檻時圖片會被暫緩；分類失敗時預設也會暫緩，設定 classifier.fail_open 後改為直接上線。
+func classifyUpload(ctx context.Context, c classifier.Classifier, img classifier.Image) (string, []db.ModerationLabel) {
+	if c == nil {
+		return db.ModerationPending, nil
+	}
+	result, err := c.Classify(ctx, img)
+	if err != nil {
+		log.Warn("failed to classify image", log.String("image_id", img.ID), log.Err(err))
+		if viper.GetBool("classifier.fail_open") {
+			return db.ModerationPending, nil
+		}
+		return db.ModerationHeld, []db.ModerationLabel{{Name: labelClassifierError, Score: 1}}
+	}
+	flagged := result.Flagged(classifierThreshold())
+	if len(flagged) == 0 {
+		return db.ModerationPending, nil
+	}
+	labels := make([]db.ModerationLabel, len(flagged))
+	for i, l := range flagged {
+		labels[i] = db.ModerationLabel{Name: l.Name, Score: l.Score}
+	}
+	return db.ModerationHeld, labels
+}
+
+// originalVariantURL 回傳 Cloudflare 回傳的變體 URL 中可下載的一個，優先使用 public 變體；只能以簽章取得的圖片會加上簽章。
+func originalVariantURL(variants []string) string {
+	for _, v := range variants {
+		if strings.HasSuffix(v, "/public") {
+			return cloudflare.SignDeliveryURL(v)
+		}
+	}
+	if len(variants) > 0 {
+		return cloudflare.SignDeliveryURL(variants[0])
+	}
+	return ""
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/arwoosa/media/internal/classifier"
+	"github.com/arwoosa/media/internal/db"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubClassifier struct {
+	result *classifier.Result
+	err    error
+}
+
+func (c stubClassifier) Classify(context.Context, classifier.Image) (*classifier.Result, error) {
+	return c.result, c.err
+}
+
+func TestClassifyUpload(t *testing.T) {
+	defer viper.Reset()
+	ctx := context.Background()
+
+	status, labels := classifyUpload(ctx, nil, classifier.Image{})
+	assert.Equal(t, db.ModerationPending, status)
+	assert.Nil(t, labels)
+
+	safe := stubClassifier{result: &classifier.Result{Labels: []classifier.Label{{Name: "nudity", Score: 0.2}}}}
+	status, _ = classifyUpload(ctx, safe, classifier.Image{})
+	assert.Equal(t, db.ModerationPending, status)
+
+	flagged := stubClassifier{result: &classifier.Result{Labels: []classifier.Label{{Name: "nudity", Score: 0.9}}}}
+	status, labels = classifyUpload(ctx, flagged, classifier.Image{})
+	assert.Equal(t, db.ModerationHeld, status)
+	assert.Equal(t, []db.ModerationLabel{{Name: "nudity", Score: 0.9}}, labels)
+
+	failing := stubClassifier{err: errors.New("down")}
+	status, labels = classifyUpload(ctx, failing, classifier.Image{})
+	assert.Equal(t, db.ModerationHeld, status)
+	assert.Equal(t, labelClassifierError, labels[0].Name)
+
+	viper.Set("classifier.fail_open", true)
+	status, _ = classifyUpload(ctx, failing, classifier.Image{})
+	assert.Equal(t, db.ModerationPending, status)
+}
+
+func TestOriginalVariantURL(t *testing.T) {
+	assert.Equal(t, "", originalVariantURL(nil))
+	assert.Equal(t, "https://imagedelivery.net/h/id/public",
+		originalVariantURL([]string{"https://imagedelivery.net/h/id/thumb", "https://imagedelivery.net/h/id/public"}))
+	assert.Equal(t, "https://imagedelivery.net/h/id/thumb",
+		originalVariantURL([]string{"https://imagedelivery.net/h/id/thumb"}))
+}
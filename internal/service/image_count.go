@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/rdb"
+	"github.com/arwoosa/vulpes/log"
+)
+
+const imageCountFlushLockTTL = time.Minute
+
+// FlushImageCount 將 Redis 中累積的瀏覽次數同步到資料庫。
+//  1. 取得分散式鎖，同一時間只有一個實例在同步；每次寫入資料庫前延長鎖，鎖已失去時停止同步。
+//  2. 以 RENAME 原子地把 pending 計數換成 draining，之後的瀏覽不會被這次同步影響。
+//  3. 逐一處理所有 draining（包含先前失敗留下的），寫入成功的圖片才從 draining 中移除，
+//     失敗的計數會保留到下一次同步，因此不會遺失。
+//
+// 回傳成功更新計數的圖片數量。
+func FlushImageCount(ctx context.Context) (int, error) {
+	lock, err := rdb.LockImageViewFlush(ctx, imageCountFlushLockTTL)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := lock.Unlock(context.WithoutCancel(ctx)); err != nil {
+			log.Warn("unlock image count flush failed", log.Err(err))
+		}
+	}()
+
+	if _, err = rdb.SwapImageViewCounts(ctx); err != nil {
+		return 0, err
+	}
+	keys, err := rdb.ListDrainingImageViews(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	flushed := 0
+	var errs []error
+	for _, key := range keys {
+		n, err := flushImageViews(ctx, key, lock)
+		flushed += n
+		if errors.Is(err, rdb.ErrLockLost) {
+			return flushed, err
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return flushed, errors.Join(errs...)
+}
+
+// flushImageViews 處理單一 draining hash，分兩個階段寫入並分別確認：
+//  1. 累加每小時/每日統計，寫入成功的欄位在 Redis 中標記為統計已寫入。
+//  2. 將已標記的欄位（包含先前同步留下的）加總到 images.count，成功的圖片才從 draining 中移除。
+//
+// 任一階段失敗時，重試只會重做失敗的階段，不會重複累加統計。
+// 每個階段寫入前都會延長 lock，鎖已被其他實例取得時回傳 ErrLockLost，不會與其他實例同時累加。
+func flushImageViews(ctx context.Context, key string, lock *rdb.Lock) (int, error) {
+	counts, err := rdb.GetImageViewCounts(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	views := make(map[string]db.TrafficView, len(counts))
+	statsDone := make(map[string]db.TrafficView)
+	for field, count := range counts {
+		viewField, applied := rdb.SplitStatsApplied(field)
+		imageId, viewedAt := rdb.ParseImageViewField(viewField)
+		if viewedAt.IsZero() {
+			viewedAt = now
+		}
+		view := db.TrafficView{ImageID: imageId, ViewedAt: viewedAt, Count: count}
+		if applied {
+			statsDone[field] = view
+			continue
+		}
+		views[field] = view
+	}
+
+	// 1. 累加統計並標記寫入成功的欄位
+	if err := lock.Extend(ctx, imageCountFlushLockTTL); err != nil {
+		return 0, err
+	}
+	var errs []error
+	statsApplied, err := db.IncTrafficStats(ctx, views)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	appliedCounts := make(map[string]int64, len(statsApplied))
+	for _, field := range statsApplied {
+		appliedCounts[field] = views[field].Count
+	}
+	marked, err := rdb.MarkImageViewStatsApplied(ctx, key, appliedCounts)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, field := range marked {
+		original, _ := rdb.SplitStatsApplied(field)
+		statsDone[field] = views[original]
+	}
+
+	// 2. 累加 images.count，成功的圖片才移除欄位
+	if err := lock.Extend(ctx, imageCountFlushLockTTL); err != nil {
+		return 0, errors.Join(append(errs, err)...)
+	}
+	imageCounts := map[string]int64{}
+	for _, view := range statsDone {
+		imageCounts[view.ImageID] += view.Count
+	}
+	countApplied, err := db.IncImageCounts(ctx, imageCounts)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	appliedImages := make(map[string]struct{}, len(countApplied))
+	for _, id := range countApplied {
+		appliedImages[id] = struct{}{}
+	}
+	ackFields := make([]string, 0, len(statsDone))
+	for field, view := range statsDone {
+		if _, ok := appliedImages[view.ImageID]; ok {
+			ackFields = append(ackFields, field)
+		}
+	}
+	if err := rdb.AckImageViewCounts(ctx, key, ackFields...); err != nil {
+		errs = append(errs, err)
+	}
+	return len(countApplied), errors.Join(errs...)
+}
+
+// RunImageCountSync 依照 interval 定期同步瀏覽次數，直到 ctx 結束。
+// 供沒有外部 Cron Job 呼叫 SyncImageCount 的部署使用。
+func RunImageCountSync(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			flushed, err := FlushImageCount(ctx)
+			switch {
+			case errors.Is(err, rdb.ErrLocked):
+				log.Debug("image count flush skipped, another worker holds the lock")
+			case err != nil:
+				log.Error("image count flush failed", log.Err(err), log.Int("flushed", flushed))
+			default:
+				log.Debug("image count flushed", log.Int("flushed", flushed))
+			}
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/media/internal/rdb"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultGCGracePeriod = 7 * 24 * time.Hour
+	defaultGCBatchSize   = 100
+	defaultGCMaxPerRun   = 1000
+	imageGCLockTTL       = 10 * time.Minute
+)
+
+// imageGCConfig 是回收未使用圖片的設定，讀取自 gc.*。
+// TrackedSince 是開始追蹤圖片引用的時間，之前上傳的圖片即使沒有引用紀錄也可能正在使用，不會被回收；
+// 未設定時不回收任何圖片。DryRun 預設為 true，定期回收只記錄候選圖片，需明確設為 false 才會刪除。
+type imageGCConfig struct {
+	TrackedSince time.Time
+	GracePeriod  time.Duration
+	BatchSize    int
+	MaxPerRun    int
+	DryRun       bool
+}
+
+func loadImageGCConfig() imageGCConfig {
+	cfg := imageGCConfig{
+		TrackedSince: viper.GetTime("gc.tracked_since"),
+		GracePeriod:  viper.GetDuration("gc.grace_period"),
+		BatchSize:    viper.GetInt("gc.batch_size"),
+		MaxPerRun:    viper.GetInt("gc.max_per_run"),
+		DryRun:       !viper.IsSet("gc.dry_run") || viper.GetBool("gc.dry_run"),
+	}
+	if cfg.GracePeriod <= 0 {
+		cfg.GracePeriod = defaultGCGracePeriod
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultGCBatchSize
+	}
+	if cfg.MaxPerRun <= 0 {
+		cfg.MaxPerRun = defaultGCMaxPerRun
+	}
+	return cfg
+}
+
+// ImageGCResult 是一次回收的結果。
+type ImageGCResult struct {
+	ImageIDs       []string
+	ReclaimedBytes int64
+}
+
+// CollectUnusedImages 刪除引用追蹤啟用後上傳、超過寬限期、沒有被引用也不在任何相簿中的圖片，回傳刪除的圖片與釋放的空間。
+// 審核中、保留、下架、被隱藏、等待更換內容，以及作為衍生圖片來源的圖片不會被回收。
+//  1. 取得分散式鎖，同一時間只有一個實例在回收。
+//  2. 分批找出候選圖片，透過 deleteImages 刪除，確保相簿、關係、用量與排行榜一併清除。
+//  3. 在查詢與刪除之間被引用的圖片會被 deleteImages 拒絕，這些圖片直接略過。
+//
+// dryRun 為 true 時只回傳會被刪除的圖片。
+func CollectUnusedImages(ctx context.Context, dryRun bool) (*ImageGCResult, error) {
+	cfg := loadImageGCConfig()
+	if cfg.TrackedSince.IsZero() {
+		return nil, status.Error(codes.FailedPrecondition, "gc.tracked_since is not set")
+	}
+	lock, err := rdb.LockImageGC(ctx, imageGCLockTTL)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := lock.Unlock(context.WithoutCancel(ctx)); err != nil {
+			log.Warn("unlock image gc failed", log.Err(err))
+		}
+	}()
+
+	uploadedBefore := time.Now().Add(-cfg.GracePeriod)
+	result := &ImageGCResult{ImageIDs: []string{}}
+	skipped := map[string]struct{}{}
+	for len(result.ImageIDs) < cfg.MaxPerRun {
+		limit := min(cfg.BatchSize, cfg.MaxPerRun-len(result.ImageIDs))
+		candidates, err := db.FindUnusedImages(ctx, cfg.TrackedSince, uploadedBefore, int64(limit+len(skipped)))
+		if err != nil {
+			return result, err
+		}
+		deleted := 0
+		for _, img := range candidates {
+			if _, ok := skipped[img.CloudflareID]; ok {
+				continue
+			}
+			if !dryRun {
+				err = deleteImages(ctx, false, img.CloudflareID)
+				if status.Code(err) == codes.FailedPrecondition {
+					skipped[img.CloudflareID] = struct{}{}
+					continue
+				}
+				if err != nil {
+					return result, err
+				}
+			}
+			result.ImageIDs = append(result.ImageIDs, img.CloudflareID)
+			result.ReclaimedBytes += int64(img.Size)
+			deleted++
+		}
+		// 乾跑時資料不會改變，一批就是全部的結果
+		if dryRun || deleted == 0 || len(candidates) < limit+len(skipped) {
+			break
+		}
+	}
+	return result, nil
+}
+
+// CollectUnusedImages 回收未使用的圖片（Cron Job 使用），僅限管理員；未指定 dry_run 時只列出會被刪除的圖片。
+func (s *imageServer) CollectUnusedImages(ctx context.Context, req *image.CollectUnusedImagesRequest) (*image.CollectUnusedImagesResponse, error) {
+	// 1. 確認管理員權限
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 回收圖片
+	dryRun := req.DryRun == nil || req.GetDryRun()
+	result, err := CollectUnusedImages(ctx, dryRun)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		switch {
+		case errors.Is(err, mgo.ErrReadFailed):
+			return nil, mgo.ToStatus(err).Err()
+		default:
+			return nil, rdb.ToStatus(err).Err()
+		}
+	}
+	// 3. 返回結果
+	return &image.CollectUnusedImagesResponse{
+		DryRun:         dryRun,
+		DeletedCount:   int64(len(result.ImageIDs)),
+		ReclaimedBytes: result.ReclaimedBytes,
+		ImageIds:       result.ImageIDs,
+	}, nil
+}
+
+// RunImageGC 依照 interval 定期回收未使用的圖片，直到 ctx 結束；gc.dry_run 未設為 false 時只記錄候選圖片。
+func RunImageGC(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			dryRun := loadImageGCConfig().DryRun
+			result, err := CollectUnusedImages(ctx, dryRun)
+			switch {
+			case errors.Is(err, rdb.ErrLocked):
+				log.Debug("image gc skipped, another worker holds the lock")
+			case err != nil:
+				log.Error("image gc failed", log.Err(err))
+			default:
+				log.Info("image gc finished",
+					log.Bool("dry_run", dryRun),
+					log.Int("deleted", len(result.ImageIDs)),
+					log.Int64("reclaimed_bytes", result.ReclaimedBytes))
+			}
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadImageGCConfig(t *testing.T) {
+	defer viper.Reset()
+
+	assert.Equal(t, imageGCConfig{
+		GracePeriod: defaultGCGracePeriod,
+		BatchSize:   defaultGCBatchSize,
+		MaxPerRun:   defaultGCMaxPerRun,
+		DryRun:      true,
+	}, loadImageGCConfig())
+
+	viper.Set("gc.grace_period", "24h")
+	viper.Set("gc.batch_size", 10)
+	viper.Set("gc.max_per_run", 50)
+	viper.Set("gc.tracked_since", "2026-01-01T00:00:00Z")
+	viper.Set("gc.dry_run", false)
+	assert.Equal(t, imageGCConfig{
+		TrackedSince: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		GracePeriod:  24 * time.Hour,
+		BatchSize:    10,
+		MaxPerRun:    50,
+	}, loadImageGCConfig())
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func toDbImageInfo(info *image.ImageInfo) db.ImageInfo {
+	return db.ImageInfo{
+		Title:       info.GetTitle(),
+		Description: info.GetDescription(),
+		AltText:     info.GetAltText(),
+		Copyright:   info.GetCopyright(),
+		License:     info.GetLicense(),
+		Tags:        info.GetTags(),
+	}
+}
+
+func toPbImageInfo(info db.ImageInfo) *image.ImageInfo {
+	return &image.ImageInfo{
+		Title:       info.Title,
+		Description: info.Description,
+		AltText:     info.AltText,
+		Copyright:   info.Copyright,
+		License:     info.License,
+		Tags:        info.Tags,
+	}
+}
+
+// UpdateImage 依 update_mask 更新圖片資訊，需要圖片的 editor 權限（owner 隱含 editor）。
+func (s *imageServer) UpdateImage(ctx context.Context, req *image.UpdateImageRequest) (*image.UpdateImageResponse, error) {
+	// 1. 確認使用者可以編輯圖片
+	_, err := requireImagePermission(ctx, req.GetImageId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 只更新 update_mask 指定的欄位
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+	img, err := db.UpdateImageInfo(ctx, req.GetImageId(), toDbImageInfo(req.GetInfo()), paths)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return &image.UpdateImageResponse{
+		ImageId: img.CloudflareID,
+		Info:    toPbImageInfo(img.ImageInfo),
+	}, nil
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/spf13/viper"
+)
+
+var defaultNegotiationFormats = []string{"avif", "webp"}
+
+// negotiationFormats 回傳依偏好排序、可依 Accept 標頭選擇的格式，可由 negotiation.formats 設定，設為空陣列時停用。
+func negotiationFormats() []string {
+	if viper.IsSet("negotiation.formats") {
+		return viper.GetStringSlice("negotiation.formats")
+	}
+	return defaultNegotiationFormats
+}
+
+// acceptedImageTypes 解析 Accept 標頭，回傳明確列出且 q 大於 0 的 image/* 類型，萬用字元不列入。
+func acceptedImageTypes(accept string) map[string]bool {
+	result := map[string]bool{}
+	for _, part := range strings.Split(accept, ",") {
+		fields := strings.Split(part, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(fields[0]))
+		if !strings.HasPrefix(mediaType, "image/") || mediaType == "image/*" {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			k, v, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if ok && strings.TrimSpace(k) == "q" {
+				if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+					q = f
+				}
+			}
+		}
+		if q > 0 {
+			result[strings.TrimPrefix(mediaType, "image/")] = true
+		}
+	}
+	return result
+}
+
+// negotiateFormat 依 Accept 標頭選擇偏好的格式，客戶端不支援任何偏好格式時回傳空字串（使用原始格式）。
+func negotiateFormat(accept string, formats []string) string {
+	if accept == "" || len(formats) == 0 {
+		return ""
+	}
+	accepted := acceptedImageTypes(accept)
+	for _, f := range formats {
+		if accepted[f] {
+			return f
+		}
+	}
+	return ""
+}
+
+// requestImageFormat 回傳目前請求協商出的格式。
+func requestImageFormat(ctx context.Context) string {
+	return negotiateFormat(getIncomingHeader(ctx, keyAccept), negotiationFormats())
+}
+
+// variantWithFormat 回傳具名變體轉成指定格式的 URL，無法套用格式時回傳原本的 URL 與 false。
+// resizing 模式直接包裝變體 URL；flexible 模式無法同時使用變體名稱與轉換參數，因此以變體定義的尺寸產生轉換參數，
+// 沒有變體定義時使用原本的 URL。
+func variantWithFormat(ctx context.Context, name, variantURL, format string) (string, bool) {
+	mode := transformMode()
+	if mode == transformModeResizing {
+		u, err := buildTransformURL(mode, variantURL, "format="+format)
+		if err != nil {
+			return variantURL, false
+		}
+		return u, true
+	}
+	def, err := db.FindCachedVariant(ctx, name)
+	if err != nil {
+		if !errors.Is(err, db.ErrVariantNotFound) {
+			log.Warn("failed to find variant definition", log.String("variant", name), log.Err(err))
+		}
+		return variantURL, false
+	}
+	u, err := imageTransform{Width: def.Width, Height: def.Height, Fit: def.Fit, Format: format}.transformURL(mode, variantURL)
+	if err != nil {
+		return variantURL, false
+	}
+	return u, true
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNegotiateFormat(t *testing.T) {
+	formats := []string{"avif", "webp"}
+	chrome := "image/avif,image/webp,image/apng,image/svg+xml,image/*,*/*;q=0.8"
+	assert.Equal(t, "avif", negotiateFormat(chrome, formats))
+	assert.Equal(t, "webp", negotiateFormat("image/webp,*/*", formats))
+	assert.Equal(t, "webp", negotiateFormat("image/avif;q=0, image/webp", formats))
+	assert.Equal(t, "", negotiateFormat("image/*,*/*;q=0.8", formats))
+	assert.Equal(t, "", negotiateFormat("", formats))
+	assert.Equal(t, "webp", negotiateFormat(chrome, []string{"webp"}))
+	assert.Equal(t, "", negotiateFormat(chrome, nil))
+}
+
+func TestNegotiationFormatsConfig(t *testing.T) {
+	assert.Equal(t, []string{"avif", "webp"}, negotiationFormats())
+	viper.Set("negotiation.formats", []string{})
+	defer viper.Reset()
+	assert.Empty(t, negotiationFormats())
+}
+
+func TestResolveImageURIWithFormat(t *testing.T) {
+	viper.Set("transform.sizes", []int{320})
+	viper.Set("transform.mode", "resizing")
+	viper.Set("cloudflare.delivery_url", "https://cdn.example.com")
+	defer viper.Reset()
+	variants := map[string]string{"public": "/cdn-images/img-1/public"}
+
+	uri, applied, err := resolveImageURI(context.Background(), &image.ImageRequest{Variant: "public"}, variants, "avif")
+	assert.NoError(t, err)
+	assert.True(t, applied)
+	assert.Equal(t, "https://cdn.example.com/cdn-cgi/image/format=avif/cdn-images/img-1/public", uri)
+
+	// 請求指定的格式優先於協商結果，回應不受 Accept 標頭影響
+	uri, applied, err = resolveImageURI(context.Background(), &image.ImageRequest{Width: 320, Format: "png"}, variants, "avif")
+	assert.NoError(t, err)
+	assert.False(t, applied)
+	assert.Equal(t, "https://cdn.example.com/cdn-cgi/image/width=320,format=png/cdn-images/img-1/public", uri)
+
+	uri, applied, err = resolveImageURI(context.Background(), &image.ImageRequest{Width: 320}, variants, "webp")
+	assert.NoError(t, err)
+	assert.True(t, applied)
+	assert.Equal(t, "https://cdn.example.com/cdn-cgi/image/width=320,format=webp/cdn-images/img-1/public", uri)
+
+	// 沒有協商出格式時使用原本的變體
+	uri, applied, err = resolveImageURI(context.Background(), &image.ImageRequest{Variant: "public"}, variants, "")
+	assert.NoError(t, err)
+	assert.False(t, applied)
+	assert.Equal(t, "/cdn-images/img-1/public", uri)
+}
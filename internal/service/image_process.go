@@ -0,0 +1,357 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arwoosa/media/internal/cloudflare"
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// processMaxBytes 與上傳的大小限制相同。
+	processMaxBytes = 10485760
+	processTimeout  = 15 * time.Second
+)
+
+// processSource 是處理圖片需要的原圖資料。
+type processSource struct {
+	ImageID  string
+	Filename string
+	Format   string
+	Variants map[string]string
+	Info     db.ImageInfo
+	// Watermark 是原圖已寫入的浮水印，衍生圖片會沿用此紀錄
+	Watermark *db.AppliedWatermark
+	// Burn 是處理時要寫入的浮水印，為 nil 時不寫入
+	Burn *watermarkSpec
+}
+
+// processPlan 是處理圖片的 Cloudflare 轉換參數，以及寫入處理紀錄的操作與參數。
+type processPlan struct {
+	Operations []string
+	Parameters map[string]any
+	Options    []string
+	Width      uint32
+	Height     uint32
+}
+
+// planImageProcessing 將操作轉成 Cloudflare 的轉換參數，並計算輸出的尺寸。
+// 裁切以原圖座標計算，Cloudflare 會在旋轉之前套用，因此操作的順序不影響結果。
+func planImageProcessing(ops []*image.ImageOperation, width, height uint32) (*processPlan, error) {
+	plan := &processPlan{Parameters: map[string]any{}, Width: width, Height: height}
+	add := func(name string, params map[string]any, options ...string) error {
+		if _, ok := plan.Parameters[name]; ok {
+			return status.Errorf(codes.InvalidArgument, "operation %s specified more than once", name)
+		}
+		plan.Operations = append(plan.Operations, name)
+		plan.Parameters[name] = params
+		plan.Options = append(plan.Options, options...)
+		return nil
+	}
+	var rotate uint32
+	for _, op := range ops {
+		var err error
+		switch o := op.GetOperation().(type) {
+		case *image.ImageOperation_Crop:
+			c := o.Crop
+			if width == 0 || height == 0 {
+				return nil, status.Error(codes.FailedPrecondition, "image dimensions are unknown, cannot crop")
+			}
+			if c.GetX()+c.GetWidth() > width || c.GetY()+c.GetHeight() > height {
+				return nil, status.Errorf(codes.InvalidArgument, "crop area exceeds image size %dx%d", width, height)
+			}
+			// trim=上;右;下;左
+			trim := fmt.Sprintf("trim=%d;%d;%d;%d", c.GetY(), width-c.GetX()-c.GetWidth(), height-c.GetY()-c.GetHeight(), c.GetX())
+			err = add("crop", map[string]any{"x": c.GetX(), "y": c.GetY(), "width": c.GetWidth(), "height": c.GetHeight()}, trim)
+			plan.Width, plan.Height = c.GetWidth(), c.GetHeight()
+		case *image.ImageOperation_Rotate:
+			rotate = o.Rotate.GetDegrees()
+			err = add("rotate", map[string]any{"degrees": rotate}, "rotate="+strconv.FormatUint(uint64(rotate), 10))
+		case *image.ImageOperation_Adjust:
+			params := map[string]any{}
+			options := []string{}
+			if b := o.Adjust.GetBrightness(); b > 0 {
+				params["brightness"] = b
+				options = append(options, "brightness="+formatFloat(b))
+			}
+			if c := o.Adjust.GetContrast(); c > 0 {
+				params["contrast"] = c
+				options = append(options, "contrast="+formatFloat(c))
+			}
+			if len(options) == 0 {
+				return nil, status.Error(codes.InvalidArgument, "adjust requires brightness or contrast")
+			}
+			err = add("adjust", params, options...)
+		case *image.ImageOperation_Blur:
+			err = add("blur", map[string]any{"radius": o.Blur.GetRadius()}, "blur="+strconv.FormatUint(uint64(o.Blur.GetRadius()), 10))
+		case *image.ImageOperation_Sharpen:
+			err = add("sharpen", map[string]any{"amount": o.Sharpen.GetAmount()}, "sharpen="+formatFloat(o.Sharpen.GetAmount()))
+		case *image.ImageOperation_Convert:
+			err = add("convert", map[string]any{"format": o.Convert.GetFormat()}, "format="+o.Convert.GetFormat())
+		default:
+			return nil, status.Error(codes.InvalidArgument, "unknown operation")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if rotate == 90 || rotate == 270 {
+		plan.Width, plan.Height = plan.Height, plan.Width
+	}
+	return plan, nil
+}
+
+func formatFloat(f float32) string {
+	return strconv.FormatFloat(float64(f), 'f', -1, 32)
+}
+
+// fetchRendered 下載轉換後的圖片，超過 processMaxBytes 時回傳錯誤。
+func fetchRendered(ctx context.Context, url string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("render image: unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, processMaxBytes+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > processMaxBytes {
+		return nil, "", fmt.Errorf("render image: result exceeds %d bytes", processMaxBytes)
+	}
+	return data, resp.Header.Get("Content-Type"), nil
+}
+
+// formatOfContentType 將回應的 Content-Type 轉成 image.ImageFormat 的名稱，無法判斷時回傳 fallback。
+func formatOfContentType(contentType, fallback string) string {
+	switch strings.TrimSpace(strings.Split(contentType, ";")[0]) {
+	case "image/png":
+		return image.ImageFormat_PNG.String()
+	case "image/gif":
+		return image.ImageFormat_GIF.String()
+	case "image/jpeg":
+		return image.ImageFormat_JPEG.String()
+	case "image/webp":
+		return image.ImageFormat_WEBP.String()
+	}
+	return fallback
+}
+
+// processedFilename 回傳衍生圖片的檔名，例如 photo.jpg 經過裁切與旋轉後為 photo-crop-rotate.jpg。
+func processedFilename(filename, format string, operations []string) string {
+	ext := path.Ext(filename)
+	switch format {
+	case image.ImageFormat_PNG.String():
+		ext = ".png"
+	case image.ImageFormat_JPEG.String():
+		ext = ".jpg"
+	case image.ImageFormat_WEBP.String():
+		ext = ".webp"
+	}
+	return strings.TrimSuffix(filename, path.Ext(filename)) + "-" + strings.Join(operations, "-") + ext
+}
+
+// ProcessImage 依操作清單處理圖片，將結果存成新的衍生圖片並記錄處理紀錄，原圖不會被修改。
+// 需要原圖的 editor 權限，衍生圖片的擁有者為目前的使用者。
+func (s *imageServer) ProcessImage(ctx context.Context, req *image.ProcessImageRequest) (*image.ProcessImageResponse, error) {
+	// 1. 確認使用者可以編輯原圖
+	userId, err := requireImagePermission(ctx, req.GetImageId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	src, err := db.FindImage(ctx, req.GetImageId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 1.1. 不能提供的原圖（駁回、暫緩、下架或因檢舉被隱藏）不能處理，避免以衍生圖片繞過審核
+	if err := moderationError(src.CloudflareID, src.ModerationStatus()); err != nil {
+		return nil, err
+	}
+	if src.Hidden {
+		return nil, status.Error(codes.FailedPrecondition, "hidden image cannot be processed")
+	}
+
+	// 2. 產生轉換參數並在處理期間預留配額，衍生圖片的大小以原圖估算
+	width, height := src.Dimensions()
+	plan, err := planImageProcessing(req.GetOperations(), width, height)
+	if err != nil {
+		return nil, err
+	}
+	reservation, err := reserveStorageQuota(ctx, []*image.UploadImage{{Size: src.Size}})
+	if err != nil {
+		return nil, err
+	}
+	defer releaseStorageReservation(ctx, reservation)
+	source := processSource{
+		ImageID:   src.CloudflareID,
+		Filename:  src.Filename,
+		Format:    src.Meta["format"],
+		Variants:  src.Variants,
+		Info:      src.ImageInfo,
+		Watermark: src.Watermark,
+	}
+	// 原圖擁有者設定 burn 模式的浮水印時，將浮水印寫入衍生圖片
+	if src.Watermark == nil && watermarkWorkerURL() != "" {
+		spec, err := resolveWatermarkSpec(ctx, src.OwnerID, src.CloudflareID)
+		if err != nil {
+			return nil, db.ToStatus(err).Err()
+		}
+		if spec != nil && spec.Mode == db.WatermarkModeBurn {
+			source.Burn = spec
+			plan.Operations = append(plan.Operations, "watermark")
+			plan.Parameters["watermark"] = map[string]any{"watermark_id": spec.ID}
+		}
+	}
+
+	// 3. 記錄開始處理
+	history := db.NewProcessingHistory(
+		db.WithProcessingImage(src.CloudflareID),
+		db.WithProcessingOperation(strings.Join(plan.Operations, ","), plan.Parameters),
+		db.WithProcessingOperator(userId))
+	err = db.SaveProcessingHistory(ctx, history)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+
+	// 4. 處理圖片並記錄結果
+	processCtx, cancel := context.WithTimeout(ctx, processTimeout)
+	defer cancel()
+	resp, procErr := renderProcessedImage(processCtx, userId, source, req.GetInfo(), plan)
+	resultId := ""
+	if resp != nil {
+		resultId = resp.ImageId
+	}
+	if err := db.FinishProcessingHistory(ctx, history, resultId, procErr); err != nil {
+		log.Warn("failed to finish processing history", log.String("history_id", history.ID.Hex()), log.Err(err))
+	}
+	if procErr != nil {
+		return nil, procErr
+	}
+	resp.HistoryId = history.ID.Hex()
+	return resp, nil
+}
+
+// processRenderURL 回傳伺服器端取得處理結果的完整 URL，需要寫入浮水印時以浮水印 Worker 包裝，否則為簽章的 URL。
+func processRenderURL(src processSource, plan *processPlan) (string, error) {
+	base, ok := baseVariantURL(src.Variants)
+	if !ok {
+		return "", status.Error(codes.FailedPrecondition, "image has no variant to process")
+	}
+	renderURL, err := buildTransformURL(transformMode(), base, strings.Join(plan.Options, ","))
+	if err != nil {
+		return "", err
+	}
+	// 資料庫中的變體是相對路徑，伺服器端取得圖片需要完整的 URL
+	renderURL, ok = cloudflare.DeliveryURL(renderURL)
+	if !ok {
+		return "", status.Error(codes.FailedPrecondition, "cloudflare.delivery_url is required for image processing")
+	}
+	if src.Burn != nil {
+		return watermarkURL(watermarkWorkerURL(), renderURL, src.Burn), nil
+	}
+	return cloudflare.SignDeliveryURL(renderURL), nil
+}
+
+// renderProcessedImage 透過 Cloudflare 轉換產生處理後的圖片，上傳為新的圖片並存入資料庫。
+func renderProcessedImage(ctx context.Context, ownerId string, src processSource, info *image.ImageInfo, plan *processPlan) (*image.ProcessImageResponse, error) {
+	// 1. 以轉換 URL 產生處理後的圖片
+	renderURL, err := processRenderURL(src, plan)
+	if err != nil {
+		return nil, err
+	}
+	applied := src.Watermark
+	if src.Burn != nil {
+		applied = src.Burn.Applied(time.Now().UTC())
+	}
+	data, contentType, err := fetchRendered(ctx, renderURL)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	// 2. 上傳到 Cloudflare
+	format := formatOfContentType(contentType, src.Format)
+	uploaded, err := cloudflare.UploadImage(ctx, processedFilename(src.Filename, format, plan.Operations), contentType, bytes.NewReader(data),
+		cloudflare.ImageMetadataSize(uint64(len(data))),
+		cloudflare.ImageMetadataWidth(plan.Width),
+		cloudflare.ImageMetadataHeight(plan.Height),
+		cloudflare.ImageMetadataFormat(format))
+	if err != nil {
+		return nil, cloudflare.ToStatus(err).Err()
+	}
+
+	// 3. 存入資料庫、累加用量並寫入上傳事件，衍生圖片未指定資訊時沿用原圖的資訊
+	imageInfo := src.Info
+	if info != nil {
+		imageInfo = toDbImageInfo(info)
+	}
+	derived := db.NewImage(
+		db.WithImageCloudflareID(uploaded.ID),
+		db.WithImageFilename(uploaded.Filename),
+		db.WithImageUploaded(uploaded.Uploaded),
+		db.WithImageMeta(uploaded.Meta),
+		db.WithImageVariants(uploaded.Variants),
+		db.WithImageCount(0),
+		db.WithSize(uint64(len(data))),
+		db.WithImageOwner(ownerId),
+		db.WithImageInfo(imageInfo),
+		db.WithImageSource(src.ImageID),
+		db.WithImageWatermark(applied),
+		db.WithImageModeration(db.ModerationPending),
+		db.WithImageUsageCounted(),
+	)
+	err = db.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := mgo.Save(ctx, derived); err != nil {
+			return err
+		}
+		event := db.NewImageEvent(db.EventImageUploaded, derived, map[string]string{"source_image_id": src.ImageID})
+		if err := db.SaveOutboxEvents(ctx, event); err != nil {
+			return err
+		}
+		return db.IncStorageUsage(ctx, db.StorageDeltaOf(derived, 1))
+	})
+	if err != nil {
+		if delErr := cloudflare.DeleteImages(ctx, uploaded.ID); delErr != nil {
+			log.Warn("failed to delete orphan processed image", log.String("image_id", uploaded.ID), log.Err(delErr))
+		}
+		return nil, mgo.ToStatus(err).Err()
+	}
+
+	// 4. 建立擁有者關係
+	err = db.SaveImageUserOwner(ctx, ownerId, []string{uploaded.ID})
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return &image.ProcessImageResponse{
+		ImageId:       uploaded.ID,
+		SourceImageId: src.ImageID,
+		Variants:      signVariants(derived.Variants),
+		Metadata: &image.ImageMetadata{
+			Width:      plan.Width,
+			Height:     plan.Height,
+			Format:     image.ImageFormat(image.ImageFormat_value[format]),
+			Size:       uint64(len(data)),
+			UploadTime: uploaded.Uploaded.Format(time.RFC3339),
+		},
+	}, nil
+}
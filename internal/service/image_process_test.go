@@ -0,0 +1,97 @@
+package service
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPlanImageProcessing(t *testing.T) {
+	ops := []*image.ImageOperation{
+		{Operation: &image.ImageOperation_Rotate{Rotate: &image.RotateOperation{Degrees: 90}}},
+		{Operation: &image.ImageOperation_Crop{Crop: &image.CropOperation{X: 100, Y: 50, Width: 800, Height: 600}}},
+		{Operation: &image.ImageOperation_Adjust{Adjust: &image.AdjustOperation{Brightness: 1.2}}},
+		{Operation: &image.ImageOperation_Convert{Convert: &image.ConvertOperation{Format: "webp"}}},
+	}
+	plan, err := planImageProcessing(ops, 1920, 1080)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rotate", "crop", "adjust", "convert"}, plan.Operations)
+	assert.Equal(t, []string{"rotate=90", "trim=50;1020;430;100", "brightness=1.2", "format=webp"}, plan.Options)
+	assert.Equal(t, uint32(600), plan.Width)
+	assert.Equal(t, uint32(800), plan.Height)
+
+	_, err = planImageProcessing([]*image.ImageOperation{
+		{Operation: &image.ImageOperation_Crop{Crop: &image.CropOperation{X: 1500, Width: 800, Height: 100}}},
+	}, 1920, 1080)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = planImageProcessing([]*image.ImageOperation{
+		{Operation: &image.ImageOperation_Crop{Crop: &image.CropOperation{Width: 10, Height: 10}}},
+	}, 0, 0)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = planImageProcessing([]*image.ImageOperation{
+		{Operation: &image.ImageOperation_Blur{Blur: &image.BlurOperation{Radius: 5}}},
+		{Operation: &image.ImageOperation_Blur{Blur: &image.BlurOperation{Radius: 10}}},
+	}, 100, 100)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestProcessedFilename(t *testing.T) {
+	assert.Equal(t, "photo-crop-rotate.jpg", processedFilename("photo.jpg", "JPEG", []string{"crop", "rotate"}))
+	assert.Equal(t, "photo-convert.webp", processedFilename("photo.jpg", "WEBP", []string{"convert"}))
+	assert.Equal(t, "photo-blur.heic", processedFilename("photo.heic", "HEIC", []string{"blur"}))
+}
+
+func TestFormatOfContentType(t *testing.T) {
+	assert.Equal(t, "WEBP", formatOfContentType("image/webp", "JPEG"))
+	assert.Equal(t, "PNG", formatOfContentType("image/png; charset=binary", "JPEG"))
+	assert.Equal(t, "JPEG", formatOfContentType("application/octet-stream", "JPEG"))
+}
+
+func TestProcessRenderURL(t *testing.T) {
+	defer viper.Reset()
+	src := processSource{ImageID: "img-1", Variants: map[string]string{"public": "/cdn-images/img-1/public"}}
+	plan := &processPlan{Options: []string{"width=640", "rotate=90"}}
+
+	// 資料庫中的變體是相對路徑，沒有 delivery_url 時無法在伺服器端取得圖片
+	_, err := processRenderURL(src, plan)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	viper.Set("cloudflare.delivery_url", "https://cdn.example.com/")
+	u, err := processRenderURL(src, plan)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/cdn-images/img-1/width=640,rotate=90", u)
+
+	viper.Set("transform.mode", "resizing")
+	u, err = processRenderURL(src, plan)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/cdn-cgi/image/width=640,rotate=90/cdn-images/img-1/public", u)
+
+	// 設定簽章金鑰後，伺服器端取得的圖片 URL 需要簽章
+	viper.Set("cloudflare.signing_key", "cf-key")
+	u, err = processRenderURL(src, plan)
+	assert.NoError(t, err)
+	parsed, err := url.Parse(u)
+	assert.NoError(t, err)
+	assert.Equal(t, "/cdn-cgi/image/width=640,rotate=90/cdn-images/img-1/public", parsed.Path)
+	assert.NotEmpty(t, parsed.Query().Get("sig"))
+
+	// 寫入浮水印時 Worker 只取得圖片與浮水印的路徑，不會取得原圖的 URL
+	viper.Set("watermark.worker_url", "https://wm.example.com/draw")
+	viper.Set("watermark.signing_key", "wm-key")
+	src.Burn = &watermarkSpec{ID: "w1", Type: "image", OverlayURL: "/cdn-images/logo/public", Position: "center", Opacity: 1, Scale: 0.1, Mode: "burn"}
+	u, err = processRenderURL(src, plan)
+	assert.NoError(t, err)
+	parsed, err = url.Parse(u)
+	assert.NoError(t, err)
+	assert.Equal(t, "wm.example.com", parsed.Host)
+	assert.Equal(t, "/draw/cdn-cgi/image/width=640,rotate=90/cdn-images/img-1/public", parsed.Path)
+	assert.False(t, parsed.Query().Has("url"))
+	assert.Equal(t, "/cdn-images/logo/public", parsed.Query().Get("overlay"))
+}
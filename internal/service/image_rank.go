@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/media/internal/rdb"
+	"github.com/arwoosa/vulpes/db/mgo"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultRankLimit         = 20
+	defaultTrendingHalfLife  = 24 * time.Hour
+	defaultTrendingMaxSize   = 10000
+	maxRankCandidatesToCheck = 1000
+)
+
+// trendingHalfLife 回傳趨勢分數的半衰期，可由 rank.trending_half_life 設定。
+func trendingHalfLife() time.Duration {
+	if d := viper.GetDuration("rank.trending_half_life"); d > 0 {
+		return d
+	}
+	return defaultTrendingHalfLife
+}
+
+// trendingMaxSize 回傳趨勢榜最多保留的圖片數量，可由 rank.trending_max_size 設定。
+func trendingMaxSize() int64 {
+	if n := viper.GetInt64("rank.trending_max_size"); n > 0 {
+		return n
+	}
+	return defaultTrendingMaxSize
+}
+
+func rankLimit(limit int32) int {
+	if limit <= 0 {
+		return defaultRankLimit
+	}
+	return int(limit)
+}
+
+var rankPeriods = map[image.RankPeriod]rdb.RankPeriod{
+	image.RankPeriod_PERIOD_ALL_TIME: rdb.RankAllTime,
+	image.RankPeriod_PERIOD_DAY:      rdb.RankDay,
+	image.RankPeriod_PERIOD_WEEK:     rdb.RankWeek,
+}
+
+// rankScope 回傳排名範圍內的圖片 ID；未指定範圍時回傳 nil，代表全站排名。
+// 只能限定自己擁有的圖片，指定的圖片是否可以檢視由 rankedImagesResponse 檢查。
+func rankScope(ctx context.Context, userId, ownerId string, imageIds []string) ([]string, error) {
+	if ownerId == "" {
+		return imageIds, nil
+	}
+	if ownerId != userId {
+		return nil, status.Error(codes.PermissionDenied, "owner_id must be the current user")
+	}
+	ownerImages, err := db.ListImageIdsByOwner(ctx, ownerId)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	if len(imageIds) == 0 {
+		// 回傳非 nil 的空切片，避免沒有圖片的使用者被當成全站排名。
+		return append([]string{}, ownerImages...), nil
+	}
+	owned := make(map[string]struct{}, len(ownerImages))
+	for _, id := range ownerImages {
+		owned[id] = struct{}{}
+	}
+	result := make([]string, 0, len(imageIds))
+	for _, id := range imageIds {
+		if _, ok := owned[id]; ok {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+// rankFetcher 從排行榜取得第 offset 名起的 count 筆資料，已依分數由高到低排序。
+type rankFetcher func(offset, count int) ([]rdb.RankedImage, error)
+
+// scopedRankFetcher 在 Redis 中對範圍內的圖片排名後再分頁，範圍為 nil 時使用全站排名。
+func scopedRankFetcher(scope []string, all func(offset, count int) ([]rdb.RankedImage, error), among func(ids []string, limit int) ([]rdb.RankedImage, error)) rankFetcher {
+	if scope == nil {
+		return all
+	}
+	return func(offset, count int) ([]rdb.RankedImage, error) {
+		ranked, err := among(scope, offset+count)
+		if err != nil || len(ranked) <= offset {
+			return nil, err
+		}
+		return ranked[offset:], nil
+	}
+}
+
+// ListTopImages 依期間（日、週、全部）列出瀏覽次數最高的圖片，可限定自己擁有或指定的圖片。
+func (s *imageServer) ListTopImages(ctx context.Context, req *image.ListTopImagesRequest) (*image.RankedImagesResponse, error) {
+	// 1. 確認使用者並決定排名範圍
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := rankScope(ctx, userId, req.GetOwnerId(), req.GetImageIds())
+	if err != nil {
+		return nil, err
+	}
+	// 2. 從排行榜依序取得可以提供給使用者的圖片並返回
+	period := rankPeriods[req.GetPeriod()]
+	fetch := scopedRankFetcher(scope,
+		func(offset, count int) ([]rdb.RankedImage, error) {
+			return rdb.TopImages(ctx, period, offset, count)
+		},
+		func(ids []string, limit int) ([]rdb.RankedImage, error) {
+			return rdb.TopImagesIn(ctx, period, ids, limit)
+		})
+	return rankedImagesResponse(ctx, userId, fetch, rankLimit(req.GetLimit()), func(score float64) float64 {
+		return score
+	})
+}
+
+// ListTrendingImages 依時間衰減分數列出近期熱門的圖片，可限定自己擁有或指定的圖片。
+func (s *imageServer) ListTrendingImages(ctx context.Context, req *image.ListTrendingImagesRequest) (*image.RankedImagesResponse, error) {
+	// 1. 確認使用者並決定排名範圍
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scope, err := rankScope(ctx, userId, req.GetOwnerId(), req.GetImageIds())
+	if err != nil {
+		return nil, err
+	}
+	// 2. 將 log 分數換算成目前時間的等效瀏覽次數，依序取得可以提供給使用者的圖片並返回
+	fetch := scopedRankFetcher(scope,
+		func(offset, count int) ([]rdb.RankedImage, error) {
+			return rdb.TrendingImages(ctx, offset, count)
+		},
+		func(ids []string, limit int) ([]rdb.RankedImage, error) {
+			return rdb.TrendingImagesIn(ctx, ids, limit)
+		})
+	now, halfLife := time.Now(), trendingHalfLife()
+	return rankedImagesResponse(ctx, userId, fetch, rankLimit(req.GetLimit()), func(score float64) float64 {
+		return rdb.DecayedViews(score, now, halfLife)
+	})
+}
+
+// rankedImagesResponse 依排名順序取得圖片，略過已不存在、不能提供或使用者無權檢視的圖片，
+// 直到湊滿 limit 張或檢查過 maxRankCandidatesToCheck 張。
+func rankedImagesResponse(ctx context.Context, userId string, fetch rankFetcher, limit int, score func(float64) float64) (*image.RankedImagesResponse, error) {
+	resp := &image.RankedImagesResponse{
+		Images: make([]*image.RankedImage, 0, limit),
+	}
+	queryCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	for offset := 0; len(resp.Images) < limit && offset < maxRankCandidatesToCheck; {
+		// 1. 取得下一批候選圖片
+		ranked, err := fetch(offset, limit*2)
+		if err != nil {
+			return nil, rdb.ToStatus(err).Err()
+		}
+		if len(ranked) == 0 {
+			break
+		}
+		offset += len(ranked)
+		ids := make([]string, len(ranked))
+		for i, r := range ranked {
+			ids[i] = r.ImageID
+		}
+		images, err := db.FindImagesByCloudflareIDs(queryCtx, ids)
+		if err != nil {
+			return nil, mgo.ToStatus(err).Err()
+		}
+		// 2. 依排名順序加入可以提供給使用者的圖片
+		for _, r := range ranked {
+			img, ok := images[r.ImageID]
+			if !ok {
+				continue
+			}
+			variants, ok, err := deliverableVariants(ctx, r.ImageID, img.Variants)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			visible, err := canViewImage(ctx, userId, img.OwnerID, r.ImageID)
+			if err != nil {
+				return nil, err
+			}
+			if !visible {
+				continue
+			}
+			resp.Images = append(resp.Images, &image.RankedImage{
+				ImageId:  r.ImageID,
+				Score:    score(r.Score),
+				Variants: variants,
+			})
+			if len(resp.Images) == limit {
+				break
+			}
+		}
+		if len(ranked) < limit*2 {
+			break
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/arwoosa/media/internal/rdb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopedRankFetcher(t *testing.T) {
+	ranked := []rdb.RankedImage{{ImageID: "a", Score: 3}, {ImageID: "b", Score: 2}, {ImageID: "c", Score: 1}}
+	var gotLimit int
+	fetch := scopedRankFetcher([]string{"a", "b", "c"}, nil, func(ids []string, limit int) ([]rdb.RankedImage, error) {
+		gotLimit = limit
+		if limit > len(ranked) {
+			limit = len(ranked)
+		}
+		return ranked[:limit], nil
+	})
+
+	page, err := fetch(1, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, gotLimit)
+	assert.Equal(t, []rdb.RankedImage{{ImageID: "b", Score: 2}}, page)
+
+	page, err = fetch(3, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, page)
+}
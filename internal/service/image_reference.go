@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func referenceOf(req *image.ImageReferenceRequest) db.ImageReference {
+	return db.ImageReference{
+		Service:    req.GetService(),
+		EntityType: req.GetEntityType(),
+		EntityID:   req.GetEntityId(),
+	}
+}
+
+// AttachImage 記錄圖片被其他服務的實體引用，使用者需要能檢視該圖片；重複引用不會產生新的紀錄。
+func (s *imageServer) AttachImage(ctx context.Context, req *image.ImageReferenceRequest) (*image.ImageReferenceResponse, error) {
+	// 1. 確認使用者可以檢視圖片
+	userId, err := requireImagePermission(ctx, req.GetImageId(), db.PermissionViewer)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 記錄引用
+	ref := referenceOf(req)
+	ref.AttachedBy = userId
+	err = db.AttachImageReference(ctx, req.GetImageId(), ref)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return &image.ImageReferenceResponse{
+		Message: "Image attached successfully",
+	}, nil
+}
+
+// DetachImage 移除圖片的引用，只有建立引用的使用者或圖片的 editor 可以移除。
+func (s *imageServer) DetachImage(ctx context.Context, req *image.ImageReferenceRequest) (*image.ImageReferenceResponse, error) {
+	// 1. 取得登入的使用者與圖片
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	img, err := db.FindImage(ctx, req.GetImageId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	ref := referenceOf(req)
+	existing, ok := img.FindReference(ref)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "image reference not found")
+	}
+	// 2. 不是建立引用的使用者時，需要圖片的 editor 權限
+	if existing.AttachedBy != userId {
+		_, err = requireImagePermission(ctx, req.GetImageId(), db.PermissionEditor)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// 3. 移除引用
+	err = db.DetachImageReference(ctx, req.GetImageId(), ref)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return &image.ImageReferenceResponse{
+		Message: "Image detached successfully",
+	}, nil
+}
+
+// ListImageUsages 列出引用圖片的實體，需要圖片的 editor 權限。
+func (s *imageServer) ListImageUsages(ctx context.Context, req *image.ImageUsagesRequest) (*image.ImageUsagesResponse, error) {
+	_, err := requireImagePermission(ctx, req.GetImageId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	img, err := db.FindImage(ctx, req.GetImageId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	resp := &image.ImageUsagesResponse{
+		ImageId:    img.CloudflareID,
+		References: make([]*image.ImageReference, 0, len(img.References)),
+	}
+	for _, r := range img.References {
+		resp.References = append(resp.References, &image.ImageReference{
+			Service:    r.Service,
+			EntityType: r.EntityType,
+			EntityId:   r.EntityID,
+			AttachedBy: r.AttachedBy,
+			CreatedAt:  r.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+// imageInUseError 回傳圖片仍被引用的錯誤，附上第一個引用讓客戶端知道圖片在哪裡被使用。
+func imageInUseError(imageId string, refs []db.ImageReference) error {
+	return errorWithCode(codes.FailedPrecondition, image.ErrorCode_IMAGE_IN_USE,
+		"image is still referenced, detach it first or delete with force",
+		map[string]string{
+			"image_id":        imageId,
+			"reference_count": strconv.Itoa(len(refs)),
+			"service":         refs[0].Service,
+			"entity_type":     refs[0].EntityType,
+			"entity_id":       refs[0].EntityID,
+		})
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/vulpes/db/mgo"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultSearchLimit = 20
+	// 每次請求最多檢查的圖片數量倍數，避免大量沒有權限的圖片讓一次請求掃描整個 collection。
+	searchScanFactor = 5
+)
+
+// searchCursor 是 next_cursor 的內容，編碼成 base64 後交給客戶端。
+type searchCursor struct {
+	Relevance bool   `json:"r,omitempty"`
+	Uploaded  int64  `json:"u,omitempty"`
+	ID        string `json:"i,omitempty"`
+	Offset    int64  `json:"o,omitempty"`
+}
+
+func encodeSearchCursor(byRelevance bool, c *db.ImageSearchCursor) string {
+	sc := searchCursor{Relevance: byRelevance, Offset: c.Offset}
+	if !byRelevance {
+		sc.Uploaded = c.Uploaded.UnixNano()
+		sc.ID = c.ID.Hex()
+	}
+	data, _ := json.Marshal(sc)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeSearchCursor(byRelevance bool, cursor string) (*db.ImageSearchCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+	var sc searchCursor
+	if err := json.Unmarshal(data, &sc); err != nil || sc.Relevance != byRelevance {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+	if byRelevance {
+		return &db.ImageSearchCursor{Offset: sc.Offset}, nil
+	}
+	id, err := bson.ObjectIDFromHex(sc.ID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+	}
+	return &db.ImageSearchCursor{Uploaded: time.Unix(0, sc.Uploaded).UTC(), ID: id}, nil
+}
+
+// toImageSearch 將請求轉成搜尋條件。
+func toImageSearch(req *image.SearchImagesRequest) (db.ImageSearch, error) {
+	q := db.ImageSearch{
+		Query:   req.GetQuery(),
+		Meta:    req.GetMeta(),
+		Tags:    req.GetTags(),
+		OwnerID: req.GetOwnerId(),
+	}
+	if req.GetFormat() != image.ImageFormat_NOT_SUPPORT {
+		q.Format = req.GetFormat().String()
+	}
+	switch req.GetSort() {
+	case image.SearchSort_SORT_RELEVANCE:
+		if q.Query == "" {
+			return q, status.Error(codes.InvalidArgument, "sort by relevance requires query")
+		}
+		q.ByRelevance = true
+	case image.SearchSort_SORT_DEFAULT:
+		q.ByRelevance = q.Query != ""
+	}
+	var err error
+	if req.GetStartTime() != "" {
+		if q.UploadedFrom, err = time.Parse(time.RFC3339, req.GetStartTime()); err != nil {
+			return q, status.Errorf(codes.InvalidArgument, "invalid start_time: %v", err)
+		}
+	}
+	if req.GetEndTime() != "" {
+		if q.UploadedTo, err = time.Parse(time.RFC3339, req.GetEndTime()); err != nil {
+			return q, status.Errorf(codes.InvalidArgument, "invalid end_time: %v", err)
+		}
+	}
+	return q, nil
+}
+
+// SearchImages 搜尋目前使用者可以檢視的圖片。
+//  1. 使用者擁有的圖片直接回傳，其他圖片需要通過關係庫的 viewer 檢查（包含從相簿繼承的權限）。
+//  2. 每次請求最多檢查 limit * searchScanFactor 張圖片，因此結果可能少於 limit，但只要 next_cursor 不為空就還有下一頁。
+func (s *imageServer) SearchImages(ctx context.Context, req *image.SearchImagesRequest) (*image.SearchImagesResponse, error) {
+	// 1. 取得使用者並解析搜尋條件
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	q, err := toImageSearch(req)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeSearchCursor(q.ByRelevance, req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+
+	// 2. 分批搜尋並過濾沒有權限的圖片
+	resp := &image.SearchImagesResponse{
+		Images: make([]*image.SearchImageResult, 0, limit),
+	}
+	scanned, exhausted := 0, false
+	for len(resp.Images) < limit && scanned < limit*searchScanFactor && !exhausted {
+		queryCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+		hits, err := db.SearchImages(queryCtx, q, cursor, int64(limit))
+		cancel()
+		if err != nil {
+			return nil, mgo.ToStatus(err).Err()
+		}
+		exhausted = len(hits) < limit
+		for i, hit := range hits {
+			cursor = nextSearchCursor(q.ByRelevance, cursor, hit)
+			scanned++
+			ok, err := canViewImage(ctx, userId, hit.Image.OwnerID, hit.Image.CloudflareID)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				err = appendSearchResult(ctx, userId, resp, hit)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if len(resp.Images) >= limit {
+				exhausted = exhausted && i == len(hits)-1
+				break
+			}
+		}
+	}
+
+	// 3. 還有未檢查的結果時回傳下一頁的 cursor
+	if !exhausted && cursor != nil {
+		resp.NextCursor = encodeSearchCursor(q.ByRelevance, cursor)
+	}
+	return resp, nil
+}
+
+// appendSearchResult 將可以提供給請求者的圖片加入搜尋結果，非擁有者的 URL 會疊加浮水印。
+// 不能提供的圖片（駁回、暫緩、下架或被隱藏）不列給其他人，擁有者仍可看到圖片但不提供變體 URL。
+func appendSearchResult(ctx context.Context, userId string, resp *image.SearchImagesResponse, hit db.ImageSearchHit) error {
+	variants, deliverable, err := deliverableVariants(ctx, hit.Image.CloudflareID, hit.Image.Variants)
+	if err != nil {
+		return err
+	}
+	if !deliverable && (userId == "" || hit.Image.OwnerID != userId) {
+		return nil
+	}
+	result := toSearchImageResult(hit)
+	result.Variants = variants
+	resp.Images = append(resp.Images, result)
+	return nil
+}
+
+// nextSearchCursor 回傳從 hit 之後繼續搜尋的 cursor。
+func nextSearchCursor(byRelevance bool, prev *db.ImageSearchCursor, hit db.ImageSearchHit) *db.ImageSearchCursor {
+	if byRelevance {
+		next := &db.ImageSearchCursor{Offset: 1}
+		if prev != nil {
+			next.Offset += prev.Offset
+		}
+		return next
+	}
+	return &db.ImageSearchCursor{Uploaded: hit.Image.Uploaded, ID: hit.Image.ID}
+}
+
+// canViewImage 檢查使用者是否可以檢視圖片，擁有者不需要查詢關係庫。
+func canViewImage(ctx context.Context, userId, ownerId, imageId string) (bool, error) {
+	if ownerId != "" && ownerId == userId {
+		return true, nil
+	}
+	ok, err := db.CheckImageUserPermission(ctx, userId, imageId, db.PermissionViewer)
+	if err != nil {
+		return false, db.ToStatus(err).Err()
+	}
+	return ok, nil
+}
+
+func toSearchImageResult(hit db.ImageSearchHit) *image.SearchImageResult {
+	img := hit.Image
+	return &image.SearchImageResult{
+		ImageId:  img.CloudflareID,
+		OwnerId:  img.OwnerID,
+		Filename: img.Filename,
+		Uploaded: img.Uploaded.UTC().Format(time.RFC3339),
+		Size:     img.Size,
+		Info:     toPbImageInfo(img.ImageInfo),
+		Variants: img.Variants,
+		Score:    hit.Score,
+	}
+}
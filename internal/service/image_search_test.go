@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearchCursorRoundTrip(t *testing.T) {
+	recent := &db.ImageSearchCursor{Uploaded: time.Date(2025, 8, 1, 10, 0, 0, 0, time.UTC), ID: bson.NewObjectID()}
+	decoded, err := decodeSearchCursor(false, encodeSearchCursor(false, recent))
+	assert.NoError(t, err)
+	assert.Equal(t, recent, decoded)
+
+	relevance := &db.ImageSearchCursor{Offset: 40}
+	decoded, err = decodeSearchCursor(true, encodeSearchCursor(true, relevance))
+	assert.NoError(t, err)
+	assert.Equal(t, relevance, decoded)
+
+	// 排序方式不同的 cursor 不能混用
+	_, err = decodeSearchCursor(true, encodeSearchCursor(false, recent))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = decodeSearchCursor(false, "not-a-cursor")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestToImageSearch(t *testing.T) {
+	q, err := toImageSearch(&image.SearchImagesRequest{
+		Query:     "sunset",
+		Format:    image.ImageFormat_JPEG,
+		StartTime: "2025-08-01T00:00:00Z",
+	})
+	assert.NoError(t, err)
+	assert.True(t, q.ByRelevance)
+	assert.Equal(t, "JPEG", q.Format)
+	assert.Equal(t, time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), q.UploadedFrom)
+	assert.True(t, q.UploadedTo.IsZero())
+
+	q, err = toImageSearch(&image.SearchImagesRequest{Query: "sunset", Sort: image.SearchSort_SORT_RECENT})
+	assert.NoError(t, err)
+	assert.False(t, q.ByRelevance)
+
+	_, err = toImageSearch(&image.SearchImagesRequest{Sort: image.SearchSort_SORT_RELEVANCE})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// srcsetMaxDPR 是 srcset 會涵蓋的最大像素密度，超過 max_width*srcsetMaxDPR 的候選圖片不會列出。
+	srcsetMaxDPR = 2
+	// srcsetRatioTolerance 是比對長寬比時允許的相對誤差。
+	srcsetRatioTolerance = 0.05
+)
+
+// srcsetCandidate 是 srcset 的候選圖片，Width 與 Height 為輸出的像素尺寸。
+type srcsetCandidate struct {
+	Variant string
+	URL     string
+	Width   uint32
+	Height  uint32
+}
+
+// parseAspectRatio 將 16:9 這類的字串轉成寬除以高，空字串回傳 0。
+func parseAspectRatio(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	w, h, ok := strings.Cut(s, ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid aspect ratio: %s", s)
+	}
+	fw, err := strconv.ParseFloat(w, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid aspect ratio: %s", s)
+	}
+	fh, err := strconv.ParseFloat(h, 64)
+	if err != nil || fw <= 0 || fh <= 0 {
+		return 0, fmt.Errorf("invalid aspect ratio: %s", s)
+	}
+	return fw / fh, nil
+}
+
+// selectSrcset 依顯示寬度與長寬比挑選候選圖片，回傳依寬度由小到大排列且寬度不重複的結果。
+// 沒有符合長寬比的候選時忽略長寬比；列出的最大寬度至少涵蓋 maxWidth*srcsetMaxDPR。
+func selectSrcset(candidates []srcsetCandidate, maxWidth uint32, ratio float64) []srcsetCandidate {
+	valid := make([]srcsetCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Width > 0 && c.URL != "" {
+			valid = append(valid, c)
+		}
+	}
+	if ratio > 0 {
+		matched := make([]srcsetCandidate, 0, len(valid))
+		for _, c := range valid {
+			if c.Height > 0 && math.Abs(float64(c.Width)/float64(c.Height)-ratio)/ratio <= srcsetRatioTolerance {
+				matched = append(matched, c)
+			}
+		}
+		if len(matched) > 0 {
+			valid = matched
+		}
+	}
+	sort.SliceStable(valid, func(i, j int) bool {
+		return valid[i].Width < valid[j].Width
+	})
+
+	result := make([]srcsetCandidate, 0, len(valid))
+	limit := maxWidth * srcsetMaxDPR
+	for _, c := range valid {
+		if len(result) > 0 && result[len(result)-1].Width == c.Width {
+			continue
+		}
+		result = append(result, c)
+		if maxWidth > 0 && c.Width >= limit {
+			break
+		}
+	}
+	return result
+}
+
+// srcsetSrc 回傳不支援 srcset 時使用的圖片：寬度足以顯示 maxWidth 的最小圖片，沒有則為最大的圖片。
+func srcsetSrc(sources []srcsetCandidate, maxWidth uint32) srcsetCandidate {
+	if maxWidth > 0 {
+		for _, c := range sources {
+			if c.Width >= maxWidth {
+				return c
+			}
+		}
+	}
+	return sources[len(sources)-1]
+}
+
+// srcsetSizes 回傳建議的 sizes 屬性。
+func srcsetSizes(maxWidth uint32) string {
+	if maxWidth == 0 {
+		return "100vw"
+	}
+	return fmt.Sprintf("(max-width: %dpx) 100vw, %dpx", maxWidth, maxWidth)
+}
+
+// transformCandidates 在沒有變體定義時，以允許的轉換寬度產生候選圖片，不會超過原圖寬度。
+func transformCandidates(variants map[string]string, imageWidth, imageHeight uint32) []srcsetCandidate {
+	base, ok := baseVariantURL(variants)
+	if !ok {
+		return nil
+	}
+	mode := transformMode()
+	result := []srcsetCandidate{}
+	for _, size := range transformSizes() {
+		width := uint32(size)
+		if imageWidth > 0 && width > imageWidth {
+			continue
+		}
+		u, err := imageTransform{Width: width}.transformURL(mode, base)
+		if err != nil {
+			return nil
+		}
+		c := srcsetCandidate{URL: u, Width: width}
+		if imageWidth > 0 {
+			c.Height = uint32(math.Round(float64(width) * float64(imageHeight) / float64(imageWidth)))
+		}
+		result = append(result, c)
+	}
+	if len(result) == 0 && imageWidth > 0 {
+		result = append(result, srcsetCandidate{URL: base, Width: imageWidth, Height: imageHeight})
+	}
+	return result
+}
+
+// GetImageSrcset 依變體定義與原圖尺寸產生可直接使用的 srcset 與 sizes。
+func (s *imageServer) GetImageSrcset(ctx context.Context, req *image.SrcsetRequest) (*image.SrcsetResponse, error) {
+	ratio, err := parseAspectRatio(req.GetAspectRatio())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// 1. 查詢圖片與變體定義，不能提供的圖片不產生 srcset
+	img, err := db.FindImage(ctx, req.GetImageId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	delivery, err := imageDeliveryOf(ctx, img.CloudflareID)
+	if err != nil {
+		return nil, err
+	}
+	err = deliveryError(ctx, img.CloudflareID, delivery)
+	if err != nil {
+		return nil, err
+	}
+	definitions, err := db.ListCachedVariants(ctx)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+
+	// 2. 計算每個變體的輸出尺寸，沒有變體定義時改用即時轉換
+	imageWidth, imageHeight := img.Dimensions()
+	candidates := make([]srcsetCandidate, 0, len(definitions))
+	for _, def := range definitions {
+		u, ok := img.Variants[def.Name]
+		if !ok {
+			continue
+		}
+		width, height := def.RenderedSize(imageWidth, imageHeight)
+		candidates = append(candidates, srcsetCandidate{Variant: def.Name, URL: u, Width: width, Height: height})
+	}
+	if len(candidates) == 0 {
+		candidates = transformCandidates(img.Variants, imageWidth, imageHeight)
+	}
+
+	// 3. 挑選候選圖片，並為非擁有者疊加浮水印
+	sources := selectSrcset(candidates, req.GetMaxWidth(), ratio)
+	if len(sources) == 0 {
+		return nil, status.Error(codes.NotFound, "no variant available for srcset")
+	}
+	for i := range sources {
+		sources[i].URL = applyDeliveryWatermark(ctx, delivery, sources[i].URL)
+	}
+	resp := &image.SrcsetResponse{
+		ImageId: img.CloudflareID,
+		Src:     srcsetSrc(sources, req.GetMaxWidth()).URL,
+		Sizes:   srcsetSizes(req.GetMaxWidth()),
+		Sources: make([]*image.SrcsetSource, 0, len(sources)),
+	}
+	entries := make([]string, 0, len(sources))
+	for _, c := range sources {
+		entries = append(entries, fmt.Sprintf("%s %dw", c.URL, c.Width))
+		resp.Sources = append(resp.Sources, &image.SrcsetSource{
+			Variant: c.Variant,
+			Url:     c.URL,
+			Width:   c.Width,
+			Height:  c.Height,
+		})
+	}
+	resp.Srcset = strings.Join(entries, ", ")
+	return resp, nil
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAspectRatio(t *testing.T) {
+	r, err := parseAspectRatio("16:9")
+	assert.NoError(t, err)
+	assert.InDelta(t, 16.0/9.0, r, 1e-9)
+
+	r, err = parseAspectRatio("")
+	assert.NoError(t, err)
+	assert.Zero(t, r)
+
+	_, err = parseAspectRatio("0:9")
+	assert.Error(t, err)
+}
+
+func TestSelectSrcset(t *testing.T) {
+	candidates := []srcsetCandidate{
+		{Variant: "large", URL: "l", Width: 1600, Height: 900},
+		{Variant: "square", URL: "s", Width: 400, Height: 400},
+		{Variant: "small", URL: "m", Width: 320, Height: 180},
+		{Variant: "medium", URL: "md", Width: 800, Height: 450},
+		{Variant: "dup", URL: "d", Width: 800, Height: 450},
+		{Variant: "unknown", URL: "u"},
+	}
+
+	all := selectSrcset(candidates, 0, 0)
+	assert.Equal(t, []uint32{320, 400, 800, 1600}, widths(all))
+
+	wide := selectSrcset(candidates, 0, 16.0/9.0)
+	assert.Equal(t, []uint32{320, 800, 1600}, widths(wide))
+	assert.Equal(t, "md", wide[1].URL)
+
+	// 顯示寬度 300 時涵蓋到 2 倍像素密度即可
+	small := selectSrcset(candidates, 300, 16.0/9.0)
+	assert.Equal(t, []uint32{320, 800}, widths(small))
+	assert.Equal(t, "m", srcsetSrc(small, 300).URL)
+	assert.Equal(t, "md", srcsetSrc(small, 0).URL)
+
+	// 沒有符合長寬比的候選時忽略長寬比
+	assert.Len(t, selectSrcset(candidates, 0, 3), 4)
+}
+
+func TestTransformCandidates(t *testing.T) {
+	viper.Set("transform.sizes", []int{320, 640, 1280})
+	defer viper.Reset()
+	variants := map[string]string{"public": "/cdn-images/img-1/public"}
+
+	candidates := transformCandidates(variants, 1000, 500)
+	assert.Equal(t, []srcsetCandidate{
+		{URL: "/cdn-images/img-1/width=320", Width: 320, Height: 160},
+		{URL: "/cdn-images/img-1/width=640", Width: 640, Height: 320},
+	}, candidates)
+
+	viper.Set("transform.mode", "resizing")
+	viper.Set("cloudflare.delivery_url", "https://cdn.example.com")
+	candidates = transformCandidates(variants, 1000, 500)
+	assert.Equal(t, "https://cdn.example.com/cdn-cgi/image/width=320/cdn-images/img-1/public", candidates[0].URL)
+
+	// 原圖比所有允許的寬度都小時使用原本的變體
+	assert.Equal(t, []srcsetCandidate{{URL: "/cdn-images/img-1/public", Width: 200, Height: 100}},
+		transformCandidates(variants, 200, 100))
+}
+
+func TestSrcsetSizes(t *testing.T) {
+	assert.Equal(t, "100vw", srcsetSizes(0))
+	assert.Equal(t, "(max-width: 640px) 100vw, 640px", srcsetSizes(640))
+}
+
+func widths(cs []srcsetCandidate) []uint32 {
+	result := make([]uint32, 0, len(cs))
+	for _, c := range cs {
+		result = append(result, c.Width)
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/vulpes/db/mgo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 單次查詢允許的最大時間範圍，避免一次展開過多的時間序列。
+const (
+	maxHourlyStatsRange = 31 * 24 * time.Hour
+	maxDailyStatsRange  = 366 * 24 * time.Hour
+)
+
+// GetImageStats 回傳圖片在指定時間範圍內每小時或每日的瀏覽次數。
+// 只有圖片的 owner 或 editor 可以查詢。
+func (s *imageServer) GetImageStats(ctx context.Context, req *image.ImageStatsRequest) (*image.ImageStatsResponse, error) {
+	// 1. 解析並檢查時間範圍
+	start, err := time.Parse(time.RFC3339, req.GetStartTime())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start_time: %v", err)
+	}
+	end, err := time.Parse(time.RFC3339, req.GetEndTime())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid end_time: %v", err)
+	}
+	if !end.After(start) {
+		return nil, status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+	granularity, maxRange := db.GranularityHour, maxHourlyStatsRange
+	if req.GetGranularity() == image.StatsGranularity_DAY {
+		granularity, maxRange = db.GranularityDay, maxDailyStatsRange
+	}
+	if end.Sub(start) > maxRange {
+		return nil, status.Errorf(codes.InvalidArgument, "time range must not exceed %s", maxRange)
+	}
+
+	// 2. 檢查權限
+	_, err = requireImagePermission(ctx, req.GetImageId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 查詢統計並補齊沒有瀏覽的區間
+	queryCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	stats, err := db.FindTrafficStats(queryCtx, req.GetImageId(), granularity, start, end)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	series := db.FillTrafficSeries(stats, granularity, start, end)
+
+	// 4. 返回時間序列
+	resp := &image.ImageStatsResponse{
+		ImageId:     req.GetImageId(),
+		Granularity: req.GetGranularity(),
+		Points:      make([]*image.StatsPoint, len(series)),
+	}
+	for i, p := range series {
+		resp.Points[i] = &image.StatsPoint{
+			Time:  p.Time.Format(time.RFC3339),
+			Views: p.Views,
+		}
+		resp.TotalViews += p.Views
+	}
+	return resp, nil
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/arwoosa/media/internal/pb/image"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	transformModeFlexible = "flexible"
+	transformModeResizing = "resizing"
+)
+
+var (
+	defaultTransformSizes     = []int{160, 320, 480, 640, 960, 1280, 1920}
+	defaultTransformQualities = []int{60, 75, 90}
+	// transformDPRs 是允許的像素密度，其他值會產生大量不同的轉換結果卻幾乎沒有差異。
+	transformDPRs = []float32{1, 2, 3}
+)
+
+// imageTransform 是 GetImageURI 的即時轉換參數，零值表示不指定。
+type imageTransform struct {
+	Width   uint32
+	Height  uint32
+	Fit     string
+	Gravity string
+	Quality uint32
+	Format  string
+	DPR     float32
+}
+
+func toImageTransform(req *image.ImageRequest) imageTransform {
+	return imageTransform{
+		Width:   req.GetWidth(),
+		Height:  req.GetHeight(),
+		Fit:     req.GetFit(),
+		Gravity: req.GetGravity(),
+		Quality: req.GetQuality(),
+		Format:  req.GetFormat(),
+		DPR:     req.GetDpr(),
+	}
+}
+
+// IsZero 回傳是否沒有指定任何轉換參數。
+func (t imageTransform) IsZero() bool {
+	return t == imageTransform{}
+}
+
+// transformSizes 回傳允許的寬高清單，可由 transform.sizes 設定，避免任意尺寸造成大量的轉換費用。
+func transformSizes() []int {
+	if sizes := viper.GetIntSlice("transform.sizes"); len(sizes) > 0 {
+		return sizes
+	}
+	return defaultTransformSizes
+}
+
+// transformQualities 回傳允許的輸出品質清單，可由 transform.qualities 設定。
+func transformQualities() []int {
+	if qualities := viper.GetIntSlice("transform.qualities"); len(qualities) > 0 {
+		return qualities
+	}
+	return defaultTransformQualities
+}
+
+// transformMode 回傳產生轉換 URL 的方式：flexible 使用 Cloudflare Images 的彈性變體，resizing 使用 delivery_url 上的 /cdn-cgi/image。
+func transformMode() string {
+	if viper.GetString("transform.mode") == transformModeResizing {
+		return transformModeResizing
+	}
+	return transformModeFlexible
+}
+
+// check 確認寬高在允許的尺寸清單中且至少指定了寬或高，品質與像素密度也只能使用允許的值。
+func (t imageTransform) check(sizes, qualities []int) error {
+	if t.Width == 0 && t.Height == 0 {
+		return status.Error(codes.InvalidArgument, "width or height is required for transformation")
+	}
+	for _, v := range []uint32{t.Width, t.Height} {
+		if v != 0 && !slices.Contains(sizes, int(v)) {
+			return errorWithCode(codes.InvalidArgument, image.ErrorCode_TRANSFORM_NOT_ALLOWED,
+				fmt.Sprintf("size %d is not allowed", v),
+				map[string]string{"allowed_sizes": joinInts(sizes)})
+		}
+	}
+	if t.Quality != 0 && !slices.Contains(qualities, int(t.Quality)) {
+		return errorWithCode(codes.InvalidArgument, image.ErrorCode_TRANSFORM_NOT_ALLOWED,
+			fmt.Sprintf("quality %d is not allowed", t.Quality),
+			map[string]string{"allowed_qualities": joinInts(qualities)})
+	}
+	if t.DPR != 0 && !slices.Contains(transformDPRs, t.DPR) {
+		return errorWithCode(codes.InvalidArgument, image.ErrorCode_TRANSFORM_NOT_ALLOWED,
+			fmt.Sprintf("dpr %g is not allowed", t.DPR),
+			map[string]string{"allowed_dprs": "1,2,3"})
+	}
+	return nil
+}
+
+func joinInts(values []int) string {
+	s := make([]string, 0, len(values))
+	for _, v := range values {
+		s = append(s, strconv.Itoa(v))
+	}
+	return strings.Join(s, ",")
+}
+
+// options 回傳 Cloudflare 的轉換參數字串，例如 width=640,fit=cover,quality=80。
+func (t imageTransform) options() string {
+	opts := []string{}
+	if t.Width > 0 {
+		opts = append(opts, "width="+strconv.FormatUint(uint64(t.Width), 10))
+	}
+	if t.Height > 0 {
+		opts = append(opts, "height="+strconv.FormatUint(uint64(t.Height), 10))
+	}
+	if t.Fit != "" {
+		opts = append(opts, "fit="+t.Fit)
+	}
+	if t.Gravity != "" {
+		opts = append(opts, "gravity="+t.Gravity)
+	}
+	if t.Quality > 0 {
+		opts = append(opts, "quality="+strconv.FormatUint(uint64(t.Quality), 10))
+	}
+	if t.Format != "" {
+		opts = append(opts, "format="+t.Format)
+	}
+	if t.DPR > 0 {
+		opts = append(opts, "dpr="+strconv.FormatFloat(float64(t.DPR), 'f', -1, 32))
+	}
+	return strings.Join(opts, ",")
+}
+
+// baseVariantURL 回傳作為轉換來源的變體 URL，優先使用 public，否則使用名稱排序第一個變體。
+func baseVariantURL(variants map[string]string) (string, bool) {
+	if u, ok := variants["public"]; ok {
+		return u, true
+	}
+	names := make([]string, 0, len(variants))
+	for name := range variants {
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return "", false
+	}
+	sort.Strings(names)
+	return variants[names[0]], true
+}
+
+// transformURL 依設定的方式產生轉換後的圖片 URL。
+func (t imageTransform) transformURL(mode string, variantURL string) (string, error) {
+	return buildTransformURL(mode, variantURL, t.options())
+}
+
+// buildTransformURL 以 Cloudflare 的轉換參數字串產生圖片 URL，variantURL 為資料庫中的相對路徑（/cdn-images/...）。
+// flexible：/cdn-images/<id>/width=640,fit=cover
+// resizing：<delivery_url>/cdn-cgi/image/width=640,fit=cover/cdn-images/<id>/public，同一個 zone 上的來源使用相對路徑
+func buildTransformURL(mode, variantURL, options string) (string, error) {
+	switch mode {
+	case transformModeResizing:
+		base := strings.TrimSuffix(viper.GetString("cloudflare.delivery_url"), "/")
+		if base == "" {
+			return "", status.Error(codes.FailedPrecondition, "cloudflare.delivery_url is required for image resizing")
+		}
+		return base + "/cdn-cgi/image/" + options + "/" + strings.TrimPrefix(variantURL, "/"), nil
+	default:
+		u, err := url.Parse(variantURL)
+		if err != nil {
+			return "", status.Error(codes.Internal, "invalid variant url")
+		}
+		idx := strings.LastIndex(u.Path, "/")
+		if idx < 0 {
+			return "", status.Error(codes.Internal, "invalid variant url")
+		}
+		u.Path = u.Path[:idx+1] + options
+		return u.String(), nil
+	}
+}
+
+// resolveImageURI 回傳請求對應的圖片 URL：有轉換參數時產生轉換 URL，否則回傳具名變體的 URL。
+// format 為依 Accept 標頭協商出的格式，請求未指定格式時使用；formatApplied 表示 URL 是否使用了協商出的格式。
+func resolveImageURI(ctx context.Context, req *image.ImageRequest, variants map[string]string, format string) (uri string, formatApplied bool, err error) {
+	t := toImageTransform(req)
+	if t.IsZero() {
+		if req.GetVariant() == "" {
+			return "", false, status.Error(codes.InvalidArgument, "variant or transformation is required")
+		}
+		u, ok := variants[req.GetVariant()]
+		if !ok {
+			return "", false, status.Error(codes.NotFound, "Variant not found")
+		}
+		if format != "" {
+			u, formatApplied = variantWithFormat(ctx, req.GetVariant(), u, format)
+		}
+		return u, formatApplied, nil
+	}
+	if err := t.check(transformSizes(), transformQualities()); err != nil {
+		return "", false, err
+	}
+	if t.Format == "" && format != "" {
+		t.Format, formatApplied = format, true
+	}
+	base, ok := variants[req.GetVariant()]
+	if req.GetVariant() == "" {
+		base, ok = baseVariantURL(variants)
+	}
+	if !ok {
+		return "", false, status.Error(codes.NotFound, "Variant not found")
+	}
+	uri, err = t.transformURL(transformMode(), base)
+	if err != nil {
+		return "", false, err
+	}
+	return uri, formatApplied, nil
+}
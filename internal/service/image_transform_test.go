@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestImageTransformOptions(t *testing.T) {
+	tr := imageTransform{Width: 640, Fit: "cover", Gravity: "auto", Quality: 75, Format: "webp", DPR: 2}
+	assert.Equal(t, "width=640,fit=cover,gravity=auto,quality=75,format=webp,dpr=2", tr.options())
+	assert.True(t, imageTransform{}.IsZero())
+}
+
+func TestImageTransformCheck(t *testing.T) {
+	sizes, qualities := []int{320, 640}, []int{60, 75}
+	assert.NoError(t, imageTransform{Width: 640}.check(sizes, qualities))
+	assert.NoError(t, imageTransform{Width: 320, Height: 640, Quality: 75, DPR: 2}.check(sizes, qualities))
+	assert.Equal(t, codes.InvalidArgument, status.Code(imageTransform{Width: 641}.check(sizes, qualities)))
+	assert.Equal(t, codes.InvalidArgument, status.Code(imageTransform{Height: 100, Width: 320}.check(sizes, qualities)))
+	assert.Equal(t, codes.InvalidArgument, status.Code(imageTransform{Fit: "cover"}.check(sizes, qualities)))
+	assert.Equal(t, codes.InvalidArgument, status.Code(imageTransform{Width: 320, Quality: 74}.check(sizes, qualities)))
+	assert.Equal(t, codes.InvalidArgument, status.Code(imageTransform{Width: 320, DPR: 1.5}.check(sizes, qualities)))
+}
+
+func TestResolveImageURI(t *testing.T) {
+	viper.Set("transform.sizes", []int{320, 640})
+	defer viper.Reset()
+	variants := map[string]string{
+		"public":    "/cdn-images/img-1/public",
+		"thumbnail": "/cdn-images/img-1/thumbnail",
+	}
+
+	uri, _, err := resolveImageURI(context.Background(), &image.ImageRequest{Variant: "thumbnail"}, variants, "")
+	assert.NoError(t, err)
+	assert.Equal(t, variants["thumbnail"], uri)
+
+	_, _, err = resolveImageURI(context.Background(), &image.ImageRequest{Variant: "missing"}, variants, "")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, _, err = resolveImageURI(context.Background(), &image.ImageRequest{}, variants, "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	uri, _, err = resolveImageURI(context.Background(), &image.ImageRequest{Width: 320, Fit: "cover"}, variants, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "/cdn-images/img-1/width=320,fit=cover", uri)
+
+	viper.Set("transform.mode", "resizing")
+	viper.Set("cloudflare.delivery_url", "https://cdn.example.com/")
+	uri, _, err = resolveImageURI(context.Background(), &image.ImageRequest{Width: 640, Format: "avif"}, variants, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://cdn.example.com/cdn-cgi/image/width=640,format=avif/cdn-images/img-1/public", uri)
+
+	_, _, err = resolveImageURI(context.Background(), &image.ImageRequest{Width: 1000}, variants, "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
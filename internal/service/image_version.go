@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/arwoosa/media/internal/classifier"
+	"github.com/arwoosa/media/internal/cloudflare"
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/media/internal/rdb"
+	"github.com/arwoosa/vulpes/log"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReplaceImage 為既有的圖片發出新的上傳 URL，上傳完成後呼叫 CompleteReplaceImage 切換內容，圖片 ID 維持不變。
+// 需要圖片的 editor 權限；重複呼叫會取代尚未完成的更換。
+func (s *imageServer) ReplaceImage(ctx context.Context, req *image.ReplaceImageRequest) (*image.ReplaceImageResponse, error) {
+	// 1. 確認使用者可以編輯圖片
+	userId, err := requireImagePermission(ctx, req.GetImageId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	img, err := db.FindImage(ctx, req.GetImageId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	if err := replacementError(img.CloudflareID, img.ModerationStatus()); err != nil {
+		return nil, err
+	}
+
+	// 2. 為新內容增加的容量預留擁有者的配額
+	upload := req.GetImage()
+	reservation, err := reserveReplacementQuota(ctx, userId, img.OwnerID, img.UsageCounted, int64(upload.GetSize())-int64(img.Size))
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. 取得新內容的上傳 URL
+	signedCtx, cancel := context.WithTimeout(ctx, time.Second*3)
+	defer cancel()
+	signedUrl, err := cloudflare.GetSignedUrl(signedCtx,
+		cloudflare.ImageMetadataSize(upload.GetSize()),
+		cloudflare.ImageMetadataWidth(upload.GetWidth()),
+		cloudflare.ImageMetadataHeight(upload.GetHeight()),
+		cloudflare.ImageMetadataFormat(upload.GetContentType().String()),
+		cloudflare.ImageMetadataLatitude(upload.Latitude),
+		cloudflare.ImageMetadataLongitude(upload.Longitude))
+	if err != nil {
+		releaseStorageReservation(ctx, reservation)
+		return nil, cloudflare.ToStatus(err).Err()
+	}
+
+	// 4. 記錄尚未完成的更換，並刪除被取代的舊上傳與其預留
+	replacement := db.ImageReplacement{
+		ProviderID:  signedUrl.ID,
+		RequestedBy: userId,
+		CreatedAt:   time.Now().UTC(),
+		Size:        upload.GetSize(),
+	}
+	if reservation != nil {
+		replacement.ReservationID = reservation.ID
+	}
+	previous, err := db.SetImageReplacement(ctx, req.GetImageId(), replacement)
+	if err != nil {
+		releaseStorageReservation(ctx, reservation)
+		return nil, db.ToStatus(err).Err()
+	}
+	if previous != nil {
+		if err := cloudflare.DeleteImages(ctx, previous.ProviderID); err != nil {
+			log.Warn("failed to delete abandoned replacement upload", log.String("provider_id", previous.ProviderID), log.Err(err))
+		}
+		releaseReplacementReservation(ctx, previous)
+	}
+	return &image.ReplaceImageResponse{
+		ImageId:        req.GetImageId(),
+		SignedUrl:      signedUrl.UploadURL,
+		CurrentVersion: int32(img.CurrentVersion()),
+	}, nil
+}
+
+// CompleteReplaceImage 確認新內容已上傳到 Cloudflare，將圖片切換到新版本並保存原本的版本。
+func (s *imageServer) CompleteReplaceImage(ctx context.Context, req *image.CompleteReplaceImageRequest) (*image.ImageVersionResponse, error) {
+	// 1. 確認使用者可以編輯圖片，且有尚未完成的更換
+	userId, err := requireImagePermission(ctx, req.GetImageId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	img, err := db.FindImage(ctx, req.GetImageId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	if img.Replacement == nil {
+		return nil, db.ToStatus(db.ErrNoPendingReplacement).Err()
+	}
+	if err := replacementError(img.CloudflareID, img.ModerationStatus()); err != nil {
+		return nil, err
+	}
+
+	// 2. 查詢 Cloudflare 上的新內容
+	detailCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	detail, err := cloudflare.GetImageDetail(detailCtx, img.Replacement.ProviderID)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, "replacement image is not uploaded yet")
+	}
+
+	// 2.1. 實際上傳的大小不能超過發出 URL 時宣告並預留配額的大小
+	if img.Replacement.Size > 0 && detail.GetSize() > img.Replacement.Size {
+		discardReplacement(ctx, img.CloudflareID, img.Replacement)
+		return nil, status.Errorf(codes.FailedPrecondition, "replacement image is larger than requested: %d > %d bytes",
+			detail.GetSize(), img.Replacement.Size)
+	}
+
+	// 2.2. 與新上傳的圖片相同，比對封鎖清單並在上線前進行自動分類
+	err = rejectBlockedReplacement(ctx, userId, img.CloudflareID, detail.ID)
+	if err != nil {
+		releaseReplacementReservation(ctx, img.Replacement)
+		return nil, err
+	}
+	contentClassifier, err := classifier.FromViper()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	moderationStatus, labels := classifyUpload(ctx, contentClassifier, classifier.Image{
+		ID:       detail.ID,
+		Filename: detail.Filename,
+		Meta:     detail.Meta,
+		URL:      originalVariantURL(detail.Variants),
+	})
+	next := db.NewImageVersion(detail.ID, detail.Filename, detail.Uploaded, detail.GetSize(), detail.Meta, detail.Variants)
+	next.Moderation = &db.Moderation{Status: moderationStatus, Labels: labels}
+
+	// 3. 切換版本並保存原本的內容，同時調整用量、寫入事件並釋放預留的配額
+	updated, err := db.SwitchImageVersion(ctx, img, next, true, img.Replacement.ReservationID,
+		map[string]string{"replaced_by": userId})
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+
+	// 4. 清除快取
+	return imageVersionChanged(ctx, updated.CloudflareID, img.Snapshot(time.Time{}), updated.Snapshot(time.Time{}))
+}
+
+// reserveReplacementQuota 為更換內容增加的容量預留圖片擁有者的配額，內容變小或不計入用量的圖片不需要預留。
+// 圖片數量不變，因此只檢查容量；由擁有者以外的編輯者發出時，擁有者的角色未知，使用預設配額。
+func reserveReplacementQuota(ctx context.Context, userId, ownerId string, usageCounted bool, addBytes int64) (*db.StorageReservation, error) {
+	if !usageCounted || ownerId == "" || addBytes <= 0 {
+		return nil, nil
+	}
+	quota := quotaForRole("")
+	if userId == ownerId {
+		quota = quotaForRole(userRole(ctx))
+	}
+	return reserveOwnerQuota(ctx, ownerId, storageQuota{Role: quota.Role, MaxBytes: quota.MaxBytes}, 0, addBytes)
+}
+
+// releaseReplacementReservation 釋放更換內容預留的配額，失敗時只記錄，預留會在逾期後自動刪除。
+func releaseReplacementReservation(ctx context.Context, replacement *db.ImageReplacement) {
+	if replacement.ReservationID.IsZero() {
+		return
+	}
+	releaseStorageReservation(ctx, &db.StorageReservation{ID: replacement.ReservationID})
+}
+
+// discardReplacement 放棄尚未完成的更換：刪除新上傳的內容、清除更換紀錄並釋放預留的配額，圖片維持原本的版本。
+func discardReplacement(ctx context.Context, imageId string, replacement *db.ImageReplacement) {
+	if err := cloudflare.DeleteImages(ctx, replacement.ProviderID); err != nil {
+		log.Warn("failed to delete discarded replacement", log.String("provider_id", replacement.ProviderID), log.Err(err))
+	}
+	if err := db.ClearImageReplacement(ctx, imageId, replacement.ProviderID); err != nil {
+		log.Warn("failed to clear discarded replacement", log.String("image_id", imageId), log.Err(err))
+	}
+	releaseReplacementReservation(ctx, replacement)
+}
+
+// replacementError 回傳圖片目前不能更換內容的原因：下架的圖片更換內容會解除下架，
+// 被駁回或暫緩的圖片更換內容會略過管理員的審核決定，因此都不能更換。
+func replacementError(imageId, moderationStatus string) error {
+	switch moderationStatus {
+	case db.ModerationTakedown:
+		return status.Errorf(codes.FailedPrecondition, "image %s has been taken down and cannot be replaced", imageId)
+	case db.ModerationRejected, db.ModerationHeld:
+		return status.Errorf(codes.FailedPrecondition, "image %s is %s by moderation and cannot be replaced", imageId, moderationStatus)
+	}
+	return nil
+}
+
+// RollbackImage 將圖片回復到歷史版本，回復本身會產生新的版本號；只有擁有者可以回復。
+func (s *imageServer) RollbackImage(ctx context.Context, req *image.RollbackImageRequest) (*image.ImageVersionResponse, error) {
+	// 1. 確認使用者是圖片擁有者
+	userId, err := requireImagePermission(ctx, req.GetImageId(), db.PermissionOwner)
+	if err != nil {
+		return nil, err
+	}
+	img, err := db.FindImage(ctx, req.GetImageId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+
+	// 2. 找出要回復的版本，下架的圖片與被駁回、下架的版本不能回復
+	target, ok := img.FindVersion(int(req.GetVersion()))
+	if !ok {
+		return nil, db.ToStatus(db.ErrImageVersionNotFound).Err()
+	}
+	if err := rollbackError(img.CloudflareID, img.ModerationStatus(), target); err != nil {
+		return nil, err
+	}
+
+	// 2.1. 封鎖清單可能在版本被取代後才加入，回復前重新比對
+	blocked, err := findBlockedUploads(ctx, userId, []string{target.ProviderID})
+	if err != nil {
+		return nil, err
+	}
+	if len(blocked) > 0 {
+		return nil, blockedContentError([]string{img.CloudflareID})
+	}
+
+	// 3. 切換版本並恢復該版本的審核狀態，回復前的內容也會保存到歷史紀錄，同時調整用量並寫入事件
+	updated, err := db.SwitchImageVersion(ctx, img, target, false, bson.ObjectID{}, map[string]string{
+		"replaced_by":    userId,
+		"rolled_back_to": strconv.Itoa(target.Version),
+	})
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+
+	// 4. 清除快取
+	return imageVersionChanged(ctx, updated.CloudflareID, img.Snapshot(time.Time{}), updated.Snapshot(time.Time{}))
+}
+
+// rollbackError 回傳不能回復到 target 的原因：回復會解除目前的下架，也不能讓被駁回或下架的內容重新上線。
+func rollbackError(imageId, moderationStatus string, target db.ImageVersion) error {
+	if moderationStatus == db.ModerationTakedown {
+		return status.Errorf(codes.FailedPrecondition, "image %s has been taken down and cannot be rolled back", imageId)
+	}
+	if target.Moderation == nil {
+		return nil
+	}
+	switch target.Moderation.Status {
+	case db.ModerationRejected, db.ModerationTakedown:
+		return status.Errorf(codes.FailedPrecondition, "version %d of image %s is %s by moderation and cannot be restored",
+			target.Version, imageId, target.Moderation.Status)
+	}
+	return nil
+}
+
+// imageVersionChanged 在切換版本後清除舊內容的快取，並回傳目前版本。
+func imageVersionChanged(ctx context.Context, imageId string, previous, current db.ImageVersion) (*image.ImageVersionResponse, error) {
+	invalidateImageCaches(ctx, imageId, previous.Variants)
+	// 不能提供的圖片（駁回、暫緩或下架）不回傳變體 URL
+	variants, _, err := deliverableVariants(ctx, imageId, current.Variants)
+	if err != nil {
+		return nil, err
+	}
+	return &image.ImageVersionResponse{
+		ImageId:  imageId,
+		Version:  int32(current.Version),
+		Variants: variants,
+	}, nil
+}
+
+// ListImageVersions 列出圖片的歷史版本，需要圖片的 editor 權限。
+func (s *imageServer) ListImageVersions(ctx context.Context, req *image.ListImageVersionsRequest) (*image.ListImageVersionsResponse, error) {
+	_, err := requireImagePermission(ctx, req.GetImageId(), db.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	img, err := db.FindImage(ctx, req.GetImageId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	resp := &image.ListImageVersionsResponse{
+		ImageId:        img.CloudflareID,
+		CurrentVersion: int32(img.CurrentVersion()),
+		Versions:       make([]*image.ImageVersion, 0, len(img.History)),
+	}
+	for i := len(img.History) - 1; i >= 0; i-- {
+		v := img.History[i]
+		resp.Versions = append(resp.Versions, &image.ImageVersion{
+			Version:    int32(v.Version),
+			Filename:   v.Filename,
+			Size:       v.Size,
+			Uploaded:   v.Uploaded.UTC().Format(time.RFC3339),
+			ReplacedAt: v.ReplacedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+// invalidateImageCaches 清除圖片變體的 Redis 快取與 CDN 上舊變體的快取，失敗只記錄警告，不影響請求結果。
+func invalidateImageCaches(ctx context.Context, imageId string, oldVariants map[string]string) {
+	if err := rdb.InvalidateImageVariants(ctx, imageId); err != nil {
+		log.Warn("failed to invalidate image variants cache", log.String("image_id", imageId), log.Err(err))
+	}
+	if err := cloudflare.PurgeCache(ctx, cloudflare.DeliveryURLs(oldVariants)...); err != nil {
+		log.Warn("failed to purge cdn cache", log.String("image_id", imageId), log.Err(err))
+	}
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestReplacementError(t *testing.T) {
+	assert.NoError(t, replacementError("img-1", db.ModerationApproved))
+	assert.NoError(t, replacementError("img-1", db.ModerationPending))
+	// 下架的圖片更換內容會解除下架
+	assert.Equal(t, codes.FailedPrecondition, status.Code(replacementError("img-1", db.ModerationTakedown)))
+	// 被駁回或暫緩的圖片更換內容會略過審核決定
+	assert.Equal(t, codes.FailedPrecondition, status.Code(replacementError("img-1", db.ModerationRejected)))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(replacementError("img-1", db.ModerationHeld)))
+}
+
+func TestRollbackError(t *testing.T) {
+	approved := db.ImageVersion{Version: 1, Moderation: &db.Moderation{Status: db.ModerationApproved}}
+	assert.NoError(t, rollbackError("img-1", db.ModerationApproved, approved))
+	// 加入審核紀錄前保存的版本沒有審核狀態
+	assert.NoError(t, rollbackError("img-1", db.ModerationApproved, db.ImageVersion{Version: 1}))
+	// 回復會解除目前的下架
+	assert.Equal(t, codes.FailedPrecondition, status.Code(rollbackError("img-1", db.ModerationTakedown, approved)))
+	// 被駁回或下架的內容不能重新上線
+	for _, s := range []string{db.ModerationRejected, db.ModerationTakedown} {
+		target := db.ImageVersion{Version: 1, Moderation: &db.Moderation{Status: s}}
+		assert.Equal(t, codes.FailedPrecondition, status.Code(rollbackError("img-1", db.ModerationApproved, target)))
+	}
+}
+
+func TestReserveReplacementQuota(t *testing.T) {
+	ctx := context.Background()
+	// 內容變小、不計入用量或沒有擁有者的圖片不需要預留
+	for _, c := range []struct {
+		ownerId      string
+		usageCounted bool
+		addBytes     int64
+	}{
+		{"user-1", true, 0},
+		{"user-1", true, -100},
+		{"user-1", false, 100},
+		{"", true, 100},
+	} {
+		reservation, err := reserveReplacementQuota(ctx, "user-1", c.ownerId, c.usageCounted, c.addBytes)
+		assert.NoError(t, err)
+		assert.Nil(t, reservation)
+	}
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/interceptor"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/media/internal/pb/moderation"
+	"github.com/arwoosa/media/internal/rdb"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/ezgrpc"
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultModerationListLimit = 20
+	defaultModerationClaimTTL  = 15 * time.Minute
+)
+
+// moderationServer 實作了 moderation.ModerationServiceServer gRPC 服務，提供管理員審核圖片。
+type moderationServer struct {
+	moderation.UnimplementedModerationServiceServer
+}
+
+func init() {
+	// 將 moderationServer 注入到 ezgrpc 中，與 imageServer 共用同一個 gRPC 伺服器。
+	ezgrpc.InjectGrpcService(func(s grpc.ServiceRegistrar) {
+		moderation.RegisterModerationServiceServer(withInterceptors(s), &moderationServer{})
+	})
+	// 註冊 gRPC-Gateway 處理程序，將 HTTP 請求代理到 gRPC 服務。
+	ezgrpc.RegisterHandlerFromEndpoint(moderation.RegisterModerationServiceHandlerFromEndpoint)
+}
+
+// moderationClaimTTL 回傳認領的有效時間，可由 moderation.claim_ttl 設定。
+func moderationClaimTTL() time.Duration {
+	if d := viper.GetDuration("moderation.claim_ttl"); d > 0 {
+		return d
+	}
+	return defaultModerationClaimTTL
+}
+
+// moderationError 回傳審核狀態禁止提供圖片時的錯誤，允許提供時回傳 nil。
+func moderationError(imageId, moderationStatus string) error {
+	switch moderationStatus {
+	case db.ModerationRejected:
+		return errorWithCode(codes.PermissionDenied, image.ErrorCode_IMAGE_REJECTED,
+			"Image rejected by moderation", map[string]string{"image_id": imageId})
+	case db.ModerationHeld:
+		return errorWithCode(codes.PermissionDenied, image.ErrorCode_IMAGE_HELD,
+			"Image is held for review", map[string]string{"image_id": imageId})
+	case db.ModerationTakedown:
+		return errorWithCode(codes.PermissionDenied, image.ErrorCode_IMAGE_TAKEN_DOWN,
+			"Image has been taken down", map[string]string{"image_id": imageId})
+	}
+	return nil
+}
+
+// deliveryError 回傳圖片不能提供給請求者時的錯誤：駁回、暫緩或下架的圖片不提供給任何人，
+// 被隱藏的圖片只提供給擁有者。
+func deliveryError(ctx context.Context, imageId string, d *imageDelivery) error {
+	if err := moderationError(imageId, d.Moderation); err != nil {
+		return err
+	}
+	if d.Hidden && !d.requestedByOwner(ctx) {
+		return status.Error(codes.NotFound, "Image not found")
+	}
+	return nil
+}
+
+// deliverableVariants 回傳可以提供給請求者的變體，非擁有者取得的 URL 會疊加浮水印；圖片依 deliveryError 不能提供時回傳 false。
+func deliverableVariants(ctx context.Context, imageId string, variants map[string]string) (map[string]string, bool, error) {
+	d, err := imageDeliveryOf(ctx, imageId)
+	if err != nil {
+		return nil, false, err
+	}
+	if deliveryError(ctx, imageId, d) != nil {
+		return nil, false, nil
+	}
+	return watermarkVariants(ctx, d, variants), true, nil
+}
+
+// ListModerationQueue 列出審核佇列，預設為待審核的圖片。
+func (s *moderationServer) ListModerationQueue(ctx context.Context, req *moderation.ListModerationQueueRequest) (*moderation.ListModerationQueueResponse, error) {
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	moderationStatus := req.GetStatus()
+	if moderationStatus == "" {
+		moderationStatus = db.ModerationPending
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultModerationListLimit
+	}
+	queryCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	images, err := db.ListModerationQueue(queryCtx, moderationStatus, req.GetUnclaimedOnly(), moderationClaimTTL(), int64(req.GetOffset()), limit)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	resp := &moderation.ListModerationQueueResponse{
+		Items: make([]*moderation.ModerationItem, 0, len(images)),
+	}
+	for _, img := range images {
+		resp.Items = append(resp.Items, img.ToModerationItem())
+	}
+	return resp, nil
+}
+
+// ClaimModeration 認領待審核的圖片，避免多位管理員同時審核同一張圖片。
+func (s *moderationServer) ClaimModeration(ctx context.Context, req *moderation.ClaimModerationRequest) (*moderation.ModerationItem, error) {
+	adminId, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	img, err := db.ClaimModeration(ctx, req.GetImageId(), adminId, moderationClaimTTL())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return img.ToModerationItem(), nil
+}
+
+// ApproveImage 核准圖片，因檢舉被隱藏的圖片恢復顯示，未處理的檢舉改為 dismissed。
+func (s *moderationServer) ApproveImage(ctx context.Context, req *moderation.ModerationDecisionRequest) (*moderation.ModerationItem, error) {
+	return decideModeration(ctx, req, db.ModerationApproved, db.ReportDismissed)
+}
+
+// RejectImage 駁回圖片，駁回後不再提供圖片，未處理的檢舉改為 resolved。
+func (s *moderationServer) RejectImage(ctx context.Context, req *moderation.ModerationDecisionRequest) (*moderation.ModerationItem, error) {
+	if req.GetReason() == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required to reject an image")
+	}
+	return decideModeration(ctx, req, db.ModerationRejected, db.ReportResolved)
+}
+
+// decideModeration 記錄審核決定並關閉圖片的檢舉。
+func decideModeration(ctx context.Context, req *moderation.ModerationDecisionRequest, moderationStatus, reportStatus string) (*moderation.ModerationItem, error) {
+	// 1. 確認使用者是管理員
+	adminId, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 記錄審核決定並寫入審核事件，其他管理員認領中的圖片無法審核
+	interceptor.AuditDetail(ctx, "status", moderationStatus)
+	if req.GetReason() != "" {
+		interceptor.AuditDetail(ctx, "reason", req.GetReason())
+	}
+	var item *moderation.ModerationItem
+	err = db.WithTransaction(ctx, func(ctx context.Context) error {
+		img, err := db.DecideModeration(ctx, req.GetImageId(), adminId, moderationStatus, req.GetReason(), moderationClaimTTL())
+		if err != nil {
+			return err
+		}
+		item = img.ToModerationItem()
+		return db.SaveOutboxEvents(ctx, db.NewImageEvent(db.EventImageModerated, img, map[string]string{"moderated_by": adminId}))
+	})
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 3. 關閉圖片未處理的檢舉
+	if _, err := db.CloseReports(ctx, item.GetImageId(), reportStatus); err != nil {
+		log.Warn("failed to close image reports", log.String("image_id", item.GetImageId()), log.Err(err))
+	}
+	// 4. 清除傳遞設定的快取，讓審核結果立即生效
+	if err := rdb.InvalidateImageVariants(ctx, item.GetImageId()); err != nil {
+		log.Warn("failed to invalidate image cache", log.String("image_id", item.GetImageId()), log.Err(err))
+	}
+	return item, nil
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestModerationError(t *testing.T) {
+	assert.NoError(t, moderationError("img-1", db.ModerationPending))
+	assert.NoError(t, moderationError("img-1", db.ModerationApproved))
+
+	rejected := moderationError("img-1", db.ModerationRejected)
+	takedown := moderationError("img-1", db.ModerationTakedown)
+	assert.Equal(t, codes.PermissionDenied, status.Code(rejected))
+	assert.Equal(t, codes.PermissionDenied, status.Code(takedown))
+	assert.NotEqual(t, status.Convert(rejected).Message(), status.Convert(takedown).Message())
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/arwoosa/media/internal/broker"
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultOutboxBatchSize   = 100
+	defaultOutboxLease       = 30 * time.Second
+	defaultOutboxMaxBackoff  = 10 * time.Minute
+	outboxPublishTimeout     = 5 * time.Second
+	outboxMaxBatchesPerRelay = 10
+)
+
+var errOutboxPredecessorFailed = errors.New("an earlier event of the same image was not published")
+
+// outboxRelayConfig 是發佈 outbox 事件的設定，讀取自 outbox.*。
+type outboxRelayConfig struct {
+	BatchSize  int
+	Lease      time.Duration
+	MaxBackoff time.Duration
+}
+
+func loadOutboxRelayConfig() outboxRelayConfig {
+	cfg := outboxRelayConfig{
+		BatchSize:  viper.GetInt("outbox.batch_size"),
+		Lease:      viper.GetDuration("outbox.lease"),
+		MaxBackoff: viper.GetDuration("outbox.max_backoff"),
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultOutboxBatchSize
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = defaultOutboxLease
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultOutboxMaxBackoff
+	}
+	return cfg
+}
+
+// outboxBackoff 回傳第 attempts 次發佈失敗後的等待時間，從 1 秒開始倍增，最多 max。
+func outboxBackoff(attempts int, max time.Duration) time.Duration {
+	d := time.Second
+	for i := 1; i < attempts && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		return max
+	}
+	return d
+}
+
+// outboxEnvelope 是發佈到 broker 的事件內容。
+type outboxEnvelope struct {
+	ID          string            `json:"id"`
+	Type        string            `json:"type"`
+	AggregateID string            `json:"aggregate_id"`
+	OccurredAt  string            `json:"occurred_at"`
+	Data        map[string]string `json:"data,omitempty"`
+}
+
+// message 將事件內容轉成 broker 的訊息，以事件名稱作為 topic、圖片 ID 作為 key。
+func (env outboxEnvelope) message() (broker.Message, error) {
+	payload, err := json.Marshal(env)
+	if err != nil {
+		return broker.Message{}, err
+	}
+	return broker.Message{
+		ID:      env.ID,
+		Topic:   env.Type,
+		Key:     env.AggregateID,
+		Payload: payload,
+	}, nil
+}
+
+// RelayOutbox 將待發佈的事件發佈到 b，回傳發佈成功的數量。
+//  1. 依建立順序認領事件，認領期間其他實例不會重複發佈；同一張圖片有更早的事件尚未發佈時，之後的事件不會被認領。
+//  2. 發佈成功後標記為已發佈；在標記前中斷的事件會在 lease 到期後重新發佈，因此事件至少發佈一次。
+//  3. 發佈失敗的事件依退避時間重試，同一批次中同一張圖片之後的事件也延後，直到失敗的事件發佈後才會再被認領。
+func RelayOutbox(ctx context.Context, b broker.Broker) (int, error) {
+	cfg := loadOutboxRelayConfig()
+	published := 0
+	for batch := 0; batch < outboxMaxBatchesPerRelay; batch++ {
+		// 1. 認領事件
+		events, err := db.ClaimOutboxEvents(ctx, cfg.BatchSize, cfg.Lease)
+		if err != nil {
+			return published, err
+		}
+		failed := make(map[string]bool)
+		for _, e := range events {
+			// 2. 同一張圖片已有事件發佈失敗時延後
+			publishErr := errOutboxPredecessorFailed
+			if !failed[e.AggregateID] {
+				publishErr = publishOutboxEvent(ctx, b, outboxEnvelope{
+					ID:          e.ID.Hex(),
+					Type:        e.Type,
+					AggregateID: e.AggregateID,
+					OccurredAt:  e.CreatedAt.UTC().Format(time.RFC3339Nano),
+					Data:        e.Data,
+				})
+			}
+			// 3. 記錄發佈結果
+			if publishErr != nil {
+				failed[e.AggregateID] = true
+				retryAt := time.Now().Add(outboxBackoff(e.Attempts, cfg.MaxBackoff))
+				if err := db.MarkOutboxFailed(ctx, e.ID, publishErr, retryAt); err != nil {
+					log.Warn("failed to mark outbox event failed", log.String("event_id", e.ID.Hex()), log.Err(err))
+				}
+				continue
+			}
+			if err := db.MarkOutboxPublished(ctx, e.ID); err != nil {
+				log.Warn("failed to mark outbox event published", log.String("event_id", e.ID.Hex()), log.Err(err))
+				continue
+			}
+			published++
+		}
+		if len(events) < cfg.BatchSize {
+			break
+		}
+	}
+	return published, nil
+}
+
+func publishOutboxEvent(ctx context.Context, b broker.Broker, env outboxEnvelope) error {
+	msg, err := env.message()
+	if err != nil {
+		return err
+	}
+	publishCtx, cancel := context.WithTimeout(ctx, outboxPublishTimeout)
+	defer cancel()
+	return b.Publish(publishCtx, msg)
+}
+
+// RunOutboxRelay 以固定間隔發佈 outbox 中的事件，直到 ctx 結束。
+func RunOutboxRelay(ctx context.Context, b broker.Broker, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			published, err := RelayOutbox(ctx, b)
+			if err != nil {
+				log.Error("outbox relay failed", log.Err(err))
+			}
+			if published > 0 {
+				log.Debug("outbox events published", log.Int("count", published))
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutboxBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, outboxBackoff(1, time.Minute))
+	assert.Equal(t, 8*time.Second, outboxBackoff(4, time.Minute))
+	assert.Equal(t, time.Minute, outboxBackoff(20, time.Minute))
+}
+
+func TestLoadOutboxRelayConfig(t *testing.T) {
+	viper.Reset()
+	defer viper.Reset()
+	assert.Equal(t, outboxRelayConfig{
+		BatchSize:  defaultOutboxBatchSize,
+		Lease:      defaultOutboxLease,
+		MaxBackoff: defaultOutboxMaxBackoff,
+	}, loadOutboxRelayConfig())
+
+	viper.Set("outbox.batch_size", 10)
+	assert.Equal(t, 10, loadOutboxRelayConfig().BatchSize)
+}
+
+func TestOutboxEnvelopeMessage(t *testing.T) {
+	msg, err := outboxEnvelope{
+		ID:          "e1",
+		Type:        "image.deleted",
+		AggregateID: "img-1",
+		OccurredAt:  "2026-01-01T00:00:00Z",
+		Data:        map[string]string{"owner_id": "u1"},
+	}.message()
+	assert.NoError(t, err)
+	assert.Equal(t, "e1", msg.ID)
+	assert.Equal(t, "image.deleted", msg.Topic)
+	assert.Equal(t, "img-1", msg.Key)
+
+	var decoded map[string]any
+	assert.NoError(t, json.Unmarshal(msg.Payload, &decoded))
+	assert.Equal(t, "img-1", decoded["aggregate_id"])
+	assert.Equal(t, map[string]any{"owner_id": "u1"}, decoded["data"])
+}
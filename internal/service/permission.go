@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/vulpes/ezgrpc"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requireUser 取得目前登入的使用者 ID，未登入時回傳 Unauthenticated。
+func requireUser(ctx context.Context) (string, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return "", ezgrpc.ToStatus(err).Err()
+	}
+	if user == nil || user.ID == "" {
+		return "", status.Error(codes.Unauthenticated, "user not found")
+	}
+	return user.ID, nil
+}
+
+// adminRoles 回傳可以使用管理 API 的角色，可由 admin.roles 設定，預設為 admin。
+func adminRoles() []string {
+	if roles := viper.GetStringSlice("admin.roles"); len(roles) > 0 {
+		return roles
+	}
+	return []string{"admin"}
+}
+
+// requireAdmin 確認目前登入的使用者具有管理員角色，並回傳使用者 ID。
+func requireAdmin(ctx context.Context) (string, error) {
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	role := userRole(ctx)
+	for _, r := range adminRoles() {
+		if role != "" && strings.EqualFold(role, r) {
+			return userId, nil
+		}
+	}
+	return "", status.Error(codes.PermissionDenied, "admin role required")
+}
+
+// requireImagePermission 確認目前登入的使用者對圖片具有指定權限，並回傳使用者 ID。
+func requireImagePermission(ctx context.Context, imageId, permission string) (string, error) {
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	ok, err := db.CheckImageUserPermission(ctx, userId, imageId, permission)
+	if err != nil {
+		return "", db.ToStatus(err).Err()
+	}
+	if !ok {
+		return "", status.Errorf(codes.PermissionDenied, "user has no %s permission on image %s", permission, imageId)
+	}
+	return userId, nil
+}
+
+// requireAlbumPermission 確認目前登入的使用者對相簿具有指定權限，並回傳使用者 ID。
+func requireAlbumPermission(ctx context.Context, albumId, permission string) (string, error) {
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return "", err
+	}
+	ok, err := db.CheckAlbumUserPermission(ctx, userId, albumId, permission)
+	if err != nil {
+		return "", db.ToStatus(err).Err()
+	}
+	if !ok {
+		return "", status.Errorf(codes.PermissionDenied, "user has no %s permission on album %s", permission, albumId)
+	}
+	return userId, nil
+}
+
+// requireImagesPermission 確認使用者對每張圖片都具有指定權限，且圖片都存在。
+func requireImagesPermission(ctx context.Context, userId string, imageIds []string, permission string) error {
+	images, err := db.FindImagesByCloudflareIDs(ctx, imageIds)
+	if err != nil {
+		return db.ToStatus(err).Err()
+	}
+	for _, id := range imageIds {
+		if _, ok := images[id]; !ok {
+			return errorWithCode(codes.NotFound, image.ErrorCode_IMAGE_NOT_FOUND, "image not found: "+id, map[string]string{"image_id": id})
+		}
+		ok, err := db.CheckImageUserPermission(ctx, userId, id, permission)
+		if err != nil {
+			return db.ToStatus(err).Err()
+		}
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "user has no %s permission on image %s", permission, id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequireAdmin(t *testing.T) {
+	ctxWithRole := func(role string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-id", "u1", keyUserRole, role))
+	}
+
+	userId, err := requireAdmin(ctxWithRole("Admin"))
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", userId)
+
+	_, err = requireAdmin(ctxWithRole("business"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	viper.Set("admin.roles", []string{"ops"})
+	defer viper.Reset()
+	_, err = requireAdmin(ctxWithRole("admin"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = requireAdmin(ctxWithRole("ops"))
+	assert.NoError(t, err)
+}
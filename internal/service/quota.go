@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/image"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/ezgrpc"
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+)
+
+// storageQuota 是某個角色的儲存配額，0 表示不限制。
+type storageQuota struct {
+	Role      string
+	MaxBytes  int64
+	MaxImages int64
+}
+
+// quotaForRole 讀取角色的配額設定（quota.roles.<role>），未設定的項目使用 quota.default。
+func quotaForRole(role string) storageQuota {
+	q := storageQuota{
+		Role:      role,
+		MaxBytes:  viper.GetInt64("quota.default.max_bytes"),
+		MaxImages: viper.GetInt64("quota.default.max_images"),
+	}
+	if role == "" {
+		return q
+	}
+	prefix := "quota.roles." + role
+	if viper.IsSet(prefix + ".max_bytes") {
+		q.MaxBytes = viper.GetInt64(prefix + ".max_bytes")
+	}
+	if viper.IsSet(prefix + ".max_images") {
+		q.MaxImages = viper.GetInt64(prefix + ".max_images")
+	}
+	return q
+}
+
+// check 確認加上新的圖片後不會超過配額。
+func (q storageQuota) check(imageCount, totalBytes, addCount, addBytes int64) error {
+	if q.MaxImages > 0 && imageCount+addCount > q.MaxImages {
+		return errorWithCode(codes.ResourceExhausted, image.ErrorCode_QUOTA_EXCEEDED,
+			fmt.Sprintf("image count quota exceeded: %d + %d > %d", imageCount, addCount, q.MaxImages),
+			map[string]string{"max_images": strconv.FormatInt(q.MaxImages, 10)})
+	}
+	if q.MaxBytes > 0 && totalBytes+addBytes > q.MaxBytes {
+		return errorWithCode(codes.ResourceExhausted, image.ErrorCode_QUOTA_EXCEEDED,
+			fmt.Sprintf("storage quota exceeded: %d + %d > %d bytes", totalBytes, addBytes, q.MaxBytes),
+			map[string]string{"max_bytes": strconv.FormatInt(q.MaxBytes, 10)})
+	}
+	return nil
+}
+
+// reserveStorageQuota 在發出上傳 URL 前為這批圖片預留配額，未登入的請求不檢查也不預留。
+func reserveStorageQuota(ctx context.Context, uploads []*image.UploadImage) (*db.StorageReservation, error) {
+	user, err := ezgrpc.GetUser(ctx)
+	if err != nil {
+		return nil, ezgrpc.ToStatus(err).Err()
+	}
+	if user == nil {
+		return nil, nil
+	}
+	var addBytes int64
+	for _, u := range uploads {
+		addBytes += int64(u.GetSize())
+	}
+	return reserveOwnerQuota(ctx, user.ID, quotaForRole(userRole(ctx)), int64(len(uploads)), addBytes)
+}
+
+// reserveOwnerQuota 為 ownerId 預留 addCount 張、addBytes 的配額。
+// 先寫入預留再加總已用與預留的配額，同時發出的上傳不會一起通過檢查；超過配額時刪除這筆預留。
+func reserveOwnerQuota(ctx context.Context, ownerId string, quota storageQuota, addCount, addBytes int64) (*db.StorageReservation, error) {
+	// 1. 預留配額
+	reservation := db.NewStorageReservation(
+		db.WithReservationOwner(ownerId),
+		db.WithReservationSize(addCount, addBytes),
+	)
+	_, err := mgo.Save(ctx, reservation)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	// 2. 加總已用量與所有未過期的預留（包含這一筆）
+	usage, err := db.FindStorageUsage(ctx, ownerId)
+	if err != nil {
+		releaseStorageReservation(ctx, reservation)
+		return nil, mgo.ToStatus(err).Err()
+	}
+	reservedCount, reservedBytes, err := db.SumStorageReservations(ctx, ownerId)
+	if err != nil {
+		releaseStorageReservation(ctx, reservation)
+		return nil, mgo.ToStatus(err).Err()
+	}
+	// 3. 超過配額時釋放預留
+	err = quota.check(usage.ImageCount+reservedCount-reservation.ImageCount,
+		usage.TotalBytes+reservedBytes-reservation.TotalBytes, reservation.ImageCount, reservation.TotalBytes)
+	if err != nil {
+		releaseStorageReservation(ctx, reservation)
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// releaseStorageReservation 刪除未發出上傳 URL 的預留，失敗時只記錄，預留會在逾期後自動刪除。
+func releaseStorageReservation(ctx context.Context, reservation *db.StorageReservation) {
+	if reservation == nil {
+		return
+	}
+	if err := db.DeleteStorageReservation(context.WithoutCancel(ctx), reservation.ID); err != nil {
+		log.Warn("failed to delete storage reservation", log.String("reservation_id", reservation.ID.Hex()), log.Err(err))
+	}
+}
+
+// GetStorageUsage 回傳目前使用者的圖片數量、總容量、各格式用量以及配額。
+func (s *imageServer) GetStorageUsage(ctx context.Context, req *image.StorageUsageRequest) (*image.StorageUsageResponse, error) {
+	// 1. 取得使用者
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 查詢用量
+	usage, err := db.FindStorageUsage(ctx, userId)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	// 3. 返回用量與配額
+	quota := quotaForRole(userRole(ctx))
+	resp := &image.StorageUsageResponse{
+		OwnerId:    userId,
+		ImageCount: usage.ImageCount,
+		TotalBytes: usage.TotalBytes,
+		ByFormat:   make(map[string]*image.FormatUsage, len(usage.ByFormat)),
+		Quota: &image.StorageQuota{
+			Role:      quota.Role,
+			MaxBytes:  quota.MaxBytes,
+			MaxImages: quota.MaxImages,
+		},
+	}
+	for format, u := range usage.ByFormat {
+		resp.ByFormat[format] = &image.FormatUsage{
+			ImageCount: u.ImageCount,
+			TotalBytes: u.TotalBytes,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQuotaForRole(t *testing.T) {
+	viper.Set("quota.default.max_bytes", 1000)
+	viper.Set("quota.default.max_images", 10)
+	viper.Set("quota.roles.business.max_bytes", 5000)
+	defer viper.Reset()
+
+	assert.Equal(t, storageQuota{Role: "", MaxBytes: 1000, MaxImages: 10}, quotaForRole(""))
+	assert.Equal(t, storageQuota{Role: "business", MaxBytes: 5000, MaxImages: 10}, quotaForRole("business"))
+	assert.Equal(t, storageQuota{Role: "unknown", MaxBytes: 1000, MaxImages: 10}, quotaForRole("unknown"))
+}
+
+func TestStorageQuotaCheck(t *testing.T) {
+	q := storageQuota{MaxBytes: 1000, MaxImages: 3}
+
+	assert.NoError(t, q.check(1, 500, 2, 500))
+
+	err := q.check(2, 100, 2, 100)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	err = q.check(0, 900, 1, 101)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	unlimited := storageQuota{}
+	assert.NoError(t, unlimited.check(1000, 1<<40, 10, 1<<30))
+}
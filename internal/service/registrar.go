@@ -0,0 +1,15 @@
+package service
+
+import (
+	"github.com/arwoosa/media/internal/interceptor"
+
+	"google.golang.org/grpc"
+)
+
+// withInterceptors 包裝 ServiceRegistrar，讓註冊的服務套用 media 服務的 interceptor。
+func withInterceptors(s grpc.ServiceRegistrar) grpc.ServiceRegistrar {
+	return interceptor.WrapRegistrar(s,
+		interceptor.RateLimit(),
+		interceptor.Audit(),
+	)
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/report"
+	"github.com/arwoosa/media/internal/rdb"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/ezgrpc"
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+)
+
+const (
+	defaultReportListLimit     = 20
+	defaultReportHideThreshold = 5
+)
+
+// reportServer 實作了 report.ReportServiceServer gRPC 服務。
+type reportServer struct {
+	report.UnimplementedReportServiceServer
+}
+
+func init() {
+	// 將 reportServer 注入到 ezgrpc 中，與 imageServer 共用同一個 gRPC 伺服器。
+	ezgrpc.InjectGrpcService(func(s grpc.ServiceRegistrar) {
+		report.RegisterReportServiceServer(withInterceptors(s), &reportServer{})
+	})
+	// 註冊 gRPC-Gateway 處理程序，將 HTTP 請求代理到 gRPC 服務。
+	ezgrpc.RegisterHandlerFromEndpoint(report.RegisterReportServiceHandlerFromEndpoint)
+}
+
+// reportHideThreshold 回傳自動隱藏圖片的檢舉次數，可由 report.hide_threshold 設定；設為 0 時不自動隱藏。
+func reportHideThreshold() int {
+	if viper.IsSet("report.hide_threshold") {
+		return viper.GetInt("report.hide_threshold")
+	}
+	return defaultReportHideThreshold
+}
+
+// shouldHideReported 判斷檢舉次數是否達到自動隱藏的門檻。
+func shouldHideReported(count, threshold int) bool {
+	return threshold > 0 && count >= threshold
+}
+
+// ReportImage 檢舉圖片，同一使用者對同一張圖片重複檢舉時回傳原本未處理的檢舉，不重複計算次數。
+func (s *reportServer) ReportImage(ctx context.Context, req *report.ReportImageRequest) (*report.Report, error) {
+	// 1. 取得登入的使用者，並確認圖片存在
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	img, err := db.FindImage(ctx, req.GetImageId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 2. 建立檢舉，已有未處理的檢舉時直接回傳
+	r, created, err := db.OpenReport(ctx, db.NewReport(
+		db.WithReportImage(img.CloudflareID),
+		db.WithReporter(userId),
+		db.WithReportReason(req.GetReason(), req.GetDetail())))
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	if !created {
+		return r.ToProto(), nil
+	}
+	// 3. 累計檢舉次數，達到門檻時隱藏圖片
+	count, err := db.IncImageReportCount(ctx, img.CloudflareID)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	if shouldHideReported(count, reportHideThreshold()) {
+		var hidden bool
+		err = db.WithTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			hidden, err = db.HideImage(ctx, img.CloudflareID, "reported")
+			if err != nil || !hidden {
+				return err
+			}
+			return db.SaveOutboxEvents(ctx, db.NewImageEvent(db.EventImageModerated, img, map[string]string{
+				"moderation_status": db.ModerationPending,
+				"hidden_reason":     "reported",
+			}))
+		})
+		if err != nil {
+			return nil, mgo.ToStatus(err).Err()
+		}
+		// 4. 清除傳遞設定的快取，讓隱藏立即生效
+		if hidden {
+			log.Info("image hidden by reports", log.String("image_id", img.CloudflareID), log.Int("reports", count))
+			if err := rdb.InvalidateImageVariants(ctx, img.CloudflareID); err != nil {
+				log.Warn("failed to invalidate image cache", log.String("image_id", img.CloudflareID), log.Err(err))
+			}
+		}
+	}
+	return r.ToProto(), nil
+}
+
+// ListReports 依條件列出檢舉，需要管理員權限。
+func (s *reportServer) ListReports(ctx context.Context, req *report.ListReportsRequest) (*report.ListReportsResponse, error) {
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultReportListLimit
+	}
+	filter := db.ReportFilter{ImageID: req.GetImageId(), Status: req.GetStatus()}
+	if req.GetReason() != report.ReportReason_REASON_UNSPECIFIED {
+		filter.Reason = req.GetReason().String()
+	}
+	queryCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	reports, err := db.ListReports(queryCtx, filter, int64(req.GetOffset()), limit)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	resp := &report.ListReportsResponse{
+		Reports: make([]*report.Report, 0, len(reports)),
+	}
+	for _, r := range reports {
+		resp.Reports = append(resp.Reports, r.ToProto())
+	}
+	return resp, nil
+}
+
+// GetReportStats 統計檢舉數量與被隱藏的圖片數量，需要管理員權限。
+func (s *reportServer) GetReportStats(ctx context.Context, req *report.ReportStatsRequest) (*report.ReportStatsResponse, error) {
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := db.FindReportStats(ctx, req.GetImageId())
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	return &report.ReportStatsResponse{
+		Total:        stats.Total,
+		Open:         stats.Open,
+		ByReason:     stats.ByReason,
+		ByStatus:     stats.ByStatus,
+		HiddenImages: stats.HiddenImages,
+	}, nil
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportHideThreshold(t *testing.T) {
+	defer viper.Reset()
+	assert.Equal(t, defaultReportHideThreshold, reportHideThreshold())
+	viper.Set("report.hide_threshold", 0)
+	assert.Equal(t, 0, reportHideThreshold())
+}
+
+func TestShouldHideReported(t *testing.T) {
+	assert.False(t, shouldHideReported(4, 5))
+	assert.True(t, shouldHideReported(5, 5))
+	assert.True(t, shouldHideReported(7, 5))
+	assert.False(t, shouldHideReported(100, 0))
+}
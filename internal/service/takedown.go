@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/takedown"
+	"github.com/arwoosa/vulpes/db/mgo"
+	"github.com/arwoosa/vulpes/ezgrpc"
+	"github.com/arwoosa/vulpes/log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTakedownListLimit = 20
+
+	takedownActionTakedown = "takedown"
+	takedownActionDismiss  = "dismiss"
+	takedownActionRestore  = "restore"
+)
+
+// takedownServer 實作了 takedown.TakedownServiceServer gRPC 服務，處理著作權下架通知。
+type takedownServer struct {
+	takedown.UnimplementedTakedownServiceServer
+}
+
+func init() {
+	// 將 takedownServer 注入到 ezgrpc 中，與 imageServer 共用同一個 gRPC 伺服器。
+	ezgrpc.InjectGrpcService(func(s grpc.ServiceRegistrar) {
+		takedown.RegisterTakedownServiceServer(withInterceptors(s), &takedownServer{})
+	})
+	// 註冊 gRPC-Gateway 處理程序，將 HTTP 請求代理到 gRPC 服務。
+	ezgrpc.RegisterHandlerFromEndpoint(takedown.RegisterTakedownServiceHandlerFromEndpoint)
+}
+
+// auditTakedown 記錄下架通知的稽核紀錄，失敗只記錄警告。
+func auditTakedown(ctx context.Context, action, actorId, takedownId string, detail map[string]string) {
+	err := db.SaveAuditLog(ctx,
+		db.WithAuditAction(action),
+		db.WithAuditActor(actorId),
+		db.WithAuditResource("takedown", takedownId),
+		db.WithAuditDetail(detail))
+	if err != nil {
+		log.Warn("failed to write takedown audit log", log.String("takedown_id", takedownId), log.Err(err))
+	}
+}
+
+// FileTakedown 登錄收到的下架通知，通知中的圖片都必須存在。
+func (s *takedownServer) FileTakedown(ctx context.Context, req *takedown.FileTakedownRequest) (*takedown.Takedown, error) {
+	// 1. 確認使用者是管理員
+	adminId, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 確認圖片存在
+	images, err := db.FindImagesByCloudflareIDs(ctx, req.GetImageIds())
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	var missing []string
+	for _, id := range req.GetImageIds() {
+		if _, ok := images[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, status.Errorf(codes.NotFound, "images not found: %s", strings.Join(missing, ","))
+	}
+	// 3. 保存下架通知並記錄稽核紀錄
+	claimant := req.GetClaimant()
+	t := db.NewTakedown(
+		db.WithTakedownClaimant(db.Claimant{
+			Name:         claimant.GetName(),
+			Email:        claimant.GetEmail(),
+			Organization: claimant.GetOrganization(),
+		}),
+		db.WithTakedownNotice(req.GetNoticeText(), req.GetImageIds()),
+		db.WithTakedownFiler(adminId))
+	_, err = mgo.Save(ctx, t)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	auditTakedown(ctx, db.AuditTakedownFile, adminId, t.ID.Hex(), map[string]string{
+		"claimant":  claimant.GetName(),
+		"image_ids": strings.Join(t.ImageIDs, ","),
+	})
+	return t.ToProto(), nil
+}
+
+// ActionTakedown 處理下架通知：takedown 停止提供圖片並通知擁有者，dismiss 駁回通知，
+// restore 在收到反通知後恢復圖片並通知擁有者。
+func (s *takedownServer) ActionTakedown(ctx context.Context, req *takedown.ActionTakedownRequest) (*takedown.Takedown, error) {
+	// 1. 確認使用者是管理員
+	adminId, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 變更下架通知的狀態
+	var from []string
+	var to string
+	switch req.GetAction() {
+	case takedownActionTakedown:
+		from, to = []string{db.TakedownReceived}, db.TakedownActioned
+	case takedownActionDismiss:
+		from, to = []string{db.TakedownReceived}, db.TakedownDismissed
+	case takedownActionRestore:
+		from, to = []string{db.TakedownActioned, db.TakedownCountered}, db.TakedownRestored
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown takedown action: %s", req.GetAction())
+	}
+	t, err := db.TransitionTakedown(ctx, req.GetTakedownId(), from, to, adminId)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	auditTakedown(ctx, db.AuditTakedownAction, adminId, t.ID.Hex(), map[string]string{
+		"action": req.GetAction(),
+		"status": t.Status,
+		"note":   req.GetNote(),
+	})
+	if to == db.TakedownDismissed {
+		return t.ToProto(), nil
+	}
+
+	// 3. 停止或恢復提供圖片，並寫入狀態有變更的圖片的審核事件。
+	// 恢復時圖片回到下架前的審核狀態，仍被其他通知下架的圖片維持下架。
+	event := NotifyTakedownActioned
+	if to == db.TakedownRestored {
+		event = NotifyTakedownRestored
+	}
+	err = db.WithTransaction(ctx, func(ctx context.Context) error {
+		before, err := db.FindImagesByCloudflareIDs(ctx, t.ImageIDs)
+		if err != nil {
+			return err
+		}
+		if to == db.TakedownActioned {
+			_, err = db.TakeDownImages(ctx, t.ImageIDs, t.ModerationReason(), adminId)
+		} else {
+			_, err = db.RestoreImages(ctx, t.ImageIDs, t.ModerationReason(), adminId)
+		}
+		if err != nil {
+			return err
+		}
+		after, err := db.FindImagesByCloudflareIDs(ctx, t.ImageIDs)
+		if err != nil {
+			return err
+		}
+		events := make([]*db.OutboxEvent, 0, len(after))
+		for id, img := range after {
+			if prev, ok := before[id]; ok && prev.ModerationStatus() == img.ModerationStatus() {
+				continue
+			}
+			events = append(events, db.NewImageEvent(db.EventImageModerated, img, map[string]string{
+				"moderated_by": adminId,
+				"takedown_id":  t.ID.Hex(),
+			}))
+		}
+		return db.SaveOutboxEvents(ctx, events...)
+	})
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+
+	// 4. 清除快取讓變更立即生效，並通知圖片擁有者；仍被其他通知下架的圖片不通知恢復
+	images, err := db.FindImagesByCloudflareIDs(ctx, t.ImageIDs)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	owners := make(map[string]string, len(images))
+	for id, img := range images {
+		invalidateImageCaches(ctx, id, img.Variants)
+		if to == db.TakedownRestored && img.ModerationStatus() == db.ModerationTakedown {
+			continue
+		}
+		owners[id] = img.OwnerID
+	}
+	notifyTakedownOwners(ctx, event, t.ID.Hex(), t.Claimant.Name, req.GetNote(), owners)
+	return t.ToProto(), nil
+}
+
+// notifyTakedownOwners 依擁有者分組通知下架或恢復的圖片，通知結果記錄在稽核紀錄中。
+func notifyTakedownOwners(ctx context.Context, event, takedownId, claimant, note string, owners map[string]string) {
+	notifiers := activeOwnerNotifiers()
+	if len(notifiers) == 0 {
+		return
+	}
+	ownerIds, grouped := groupByOwner(owners)
+	for _, ownerId := range ownerIds {
+		n := OwnerNotification{
+			Event:      event,
+			OwnerID:    ownerId,
+			TakedownID: takedownId,
+			ImageIDs:   grouped[ownerId],
+			Claimant:   claimant,
+			Note:       note,
+			SentAt:     time.Now().UTC(),
+		}
+		result := "sent"
+		for _, notifier := range notifiers {
+			if err := notifier.NotifyOwner(ctx, n); err != nil {
+				result = "failed"
+				log.Warn("failed to notify image owner", log.String("owner_id", ownerId), log.String("takedown_id", takedownId), log.Err(err))
+			}
+		}
+		auditTakedown(ctx, db.AuditTakedownNotify, "", takedownId, map[string]string{
+			"event":     event,
+			"owner_id":  ownerId,
+			"image_ids": strings.Join(n.ImageIDs, ","),
+			"result":    result,
+		})
+	}
+}
+
+// GetTakedown 查詢下架通知。
+func (s *takedownServer) GetTakedown(ctx context.Context, req *takedown.GetTakedownRequest) (*takedown.Takedown, error) {
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := db.FindTakedown(ctx, req.GetTakedownId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return t.ToProto(), nil
+}
+
+// ListTakedowns 依建立時間由新到舊列出下架通知。
+func (s *takedownServer) ListTakedowns(ctx context.Context, req *takedown.ListTakedownsRequest) (*takedown.ListTakedownsResponse, error) {
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limit := int64(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultTakedownListLimit
+	}
+	queryCtx, cancel := context.WithTimeout(ctx, time.Second*2)
+	defer cancel()
+	takedowns, err := db.ListTakedowns(queryCtx, db.TakedownFilter{Status: req.GetStatus(), ImageID: req.GetImageId()}, int64(req.GetOffset()), limit)
+	if err != nil {
+		return nil, mgo.ToStatus(err).Err()
+	}
+	resp := &takedown.ListTakedownsResponse{
+		Takedowns: make([]*takedown.Takedown, 0, len(takedowns)),
+	}
+	for _, t := range takedowns {
+		resp.Takedowns = append(resp.Takedowns, t.ToProto())
+	}
+	return resp, nil
+}
+
+// FileCounterNotice 由圖片擁有者對已下架的圖片提出反通知，需要通知中至少一張圖片的 owner 權限。
+func (s *takedownServer) FileCounterNotice(ctx context.Context, req *takedown.CounterNoticeRequest) (*takedown.Takedown, error) {
+	// 1. 取得登入的使用者與下架通知
+	userId, err := requireUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := db.FindTakedown(ctx, req.GetTakedownId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 2. 找出使用者擁有的受影響圖片
+	var owned []string
+	for _, id := range t.ImageIDs {
+		ok, err := db.CheckImageUserPermission(ctx, userId, id, db.PermissionOwner)
+		if err != nil {
+			return nil, db.ToStatus(err).Err()
+		}
+		if ok {
+			owned = append(owned, id)
+		}
+	}
+	if len(owned) == 0 {
+		return nil, status.Error(codes.PermissionDenied, "user owns none of the images in this takedown")
+	}
+	// 3. 記錄反通知與稽核紀錄
+	t, err = db.AddCounterNotice(ctx, t.ID.Hex(), db.CounterNotice{
+		OwnerID:      userId,
+		ImageIDs:     owned,
+		Statement:    req.GetStatement(),
+		ContactName:  req.GetContactName(),
+		ContactEmail: req.GetContactEmail(),
+		FiledAt:      time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	auditTakedown(ctx, db.AuditTakedownCounter, userId, t.ID.Hex(), map[string]string{
+		"image_ids": strings.Join(owned, ","),
+	})
+	// 4. 只回傳使用者自己提出的反通知
+	resp := t.ToProto()
+	notices := resp.CounterNotices[:0]
+	for _, c := range resp.GetCounterNotices() {
+		if c.GetOwnerId() == userId {
+			notices = append(notices, c)
+		}
+	}
+	resp.CounterNotices = notices
+	return resp, nil
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	NotifyTakedownActioned = "takedown.actioned"
+	NotifyTakedownRestored = "takedown.restored"
+
+	defaultNotifyTimeout = 5 * time.Second
+)
+
+// OwnerNotification 是下架通知處理後送給圖片擁有者的通知。
+type OwnerNotification struct {
+	Event      string    `json:"event"`
+	OwnerID    string    `json:"owner_id"`
+	TakedownID string    `json:"takedown_id"`
+	ImageIDs   []string  `json:"image_ids"`
+	Claimant   string    `json:"claimant"`
+	Note       string    `json:"note,omitempty"`
+	SentAt     time.Time `json:"sent_at"`
+}
+
+// OwnerNotifier 將通知送給圖片擁有者，例如寄送 email 或推播。
+type OwnerNotifier interface {
+	NotifyOwner(ctx context.Context, n OwnerNotification) error
+}
+
+var (
+	ownerNotifiersMu sync.RWMutex
+	ownerNotifiers   []OwnerNotifier
+)
+
+// RegisterOwnerNotifier 註冊額外的擁有者通知方式，設定 takedown.notify_url 時也會以 webhook 通知。
+func RegisterOwnerNotifier(n OwnerNotifier) {
+	ownerNotifiersMu.Lock()
+	defer ownerNotifiersMu.Unlock()
+	ownerNotifiers = append(ownerNotifiers, n)
+}
+
+// activeOwnerNotifiers 回傳已註冊的通知方式，加上依設定建立的 webhook。
+func activeOwnerNotifiers() []OwnerNotifier {
+	ownerNotifiersMu.RLock()
+	notifiers := append([]OwnerNotifier(nil), ownerNotifiers...)
+	ownerNotifiersMu.RUnlock()
+	if url := viper.GetString("takedown.notify_url"); url != "" {
+		notifiers = append(notifiers, &webhookNotifier{
+			url:    url,
+			token:  viper.GetString("takedown.notify_token"),
+			client: &http.Client{Timeout: defaultNotifyTimeout},
+		})
+	}
+	return notifiers
+}
+
+// webhookNotifier 將通知以 JSON POST 到設定的 URL，由外部的通知服務寄送給擁有者。
+type webhookNotifier struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func (w *webhookNotifier) NotifyOwner(ctx context.Context, n OwnerNotification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.token != "" {
+		req.Header.Set("Authorization", "Bearer "+w.token)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notify owner: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// groupByOwner 將圖片依擁有者分組，擁有者與圖片都依字母排序；沒有擁有者的圖片不會出現在結果中。
+func groupByOwner(owners map[string]string) ([]string, map[string][]string) {
+	grouped := map[string][]string{}
+	for imageId, ownerId := range owners {
+		if ownerId == "" {
+			continue
+		}
+		grouped[ownerId] = append(grouped[ownerId], imageId)
+	}
+	ownerIds := make([]string, 0, len(grouped))
+	for ownerId, imageIds := range grouped {
+		sort.Strings(imageIds)
+		ownerIds = append(ownerIds, ownerId)
+	}
+	sort.Strings(ownerIds)
+	return ownerIds, grouped
+}
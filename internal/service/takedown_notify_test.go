@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupByOwner(t *testing.T) {
+	ownerIds, grouped := groupByOwner(map[string]string{
+		"img-3": "u2",
+		"img-1": "u1",
+		"img-2": "u1",
+		"img-4": "",
+	})
+	assert.Equal(t, []string{"u1", "u2"}, ownerIds)
+	assert.Equal(t, []string{"img-1", "img-2"}, grouped["u1"])
+	assert.Equal(t, []string{"img-3"}, grouped["u2"])
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	defer viper.Reset()
+	var got OwnerNotification
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer stub.Close()
+
+	assert.Empty(t, activeOwnerNotifiers())
+	viper.Set("takedown.notify_url", stub.URL)
+	viper.Set("takedown.notify_token", "secret")
+	notifiers := activeOwnerNotifiers()
+	assert.Len(t, notifiers, 1)
+
+	err := notifiers[0].NotifyOwner(context.Background(), OwnerNotification{
+		Event:      NotifyTakedownActioned,
+		OwnerID:    "u1",
+		TakedownID: "t1",
+		ImageIDs:   []string{"img-1"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", got.OwnerID)
+	assert.Equal(t, []string{"img-1"}, got.ImageIDs)
+
+	failing := httptest.NewServer(http.NotFoundHandler())
+	defer failing.Close()
+	viper.Set("takedown.notify_url", failing.URL)
+	assert.Error(t, activeOwnerNotifiers()[0].NotifyOwner(context.Background(), OwnerNotification{}))
+}
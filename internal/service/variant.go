@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/arwoosa/media/internal/cloudflare"
+	"github.com/arwoosa/media/internal/cloudflare/dao"
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/variant"
+	"github.com/arwoosa/vulpes/ezgrpc"
+	"github.com/arwoosa/vulpes/log"
+
+	"google.golang.org/grpc"
+)
+
+// variantServer 實作了 variant.VariantServiceServer gRPC 服務，管理具名的圖片變體定義。
+type variantServer struct {
+	variant.UnimplementedVariantServiceServer
+}
+
+func init() {
+	// 將 variantServer 注入到 ezgrpc 中，與 imageServer 共用同一個 gRPC 伺服器。
+	ezgrpc.InjectGrpcService(func(s grpc.ServiceRegistrar) {
+		variant.RegisterVariantServiceServer(withInterceptors(s), &variantServer{})
+	})
+	// 註冊 gRPC-Gateway 處理程序，將 HTTP 請求代理到 gRPC 服務。
+	ezgrpc.RegisterHandlerFromEndpoint(variant.RegisterVariantServiceHandlerFromEndpoint)
+}
+
+// CreateVariant 建立變體定義，先保存到資料庫確認名稱未重複，再建立 Cloudflare 上的變體。
+func (s *variantServer) CreateVariant(ctx context.Context, req *variant.VariantDefinitionRequest) (*variant.Variant, error) {
+	// 1. 確認使用者是管理員
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 保存變體定義
+	v := db.NewVariant(
+		db.WithVariantName(req.GetName()),
+		db.WithVariantSize(req.GetWidth(), req.GetHeight()),
+		db.WithVariantFit(req.GetFit()),
+		db.WithVariantMetadata(req.GetMetadata()),
+		db.WithVariantNeverRequireSignedURLs(req.GetNeverRequireSignedUrls()))
+	err = db.SaveVariant(ctx, v)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 3. 建立 Cloudflare 上的變體，失敗時移除剛保存的定義
+	err = cloudflare.CreateVariant(ctx, v.ToCloudflare())
+	if err != nil {
+		if delErr := db.DeleteVariant(ctx, v.Name); delErr != nil {
+			log.Warn("failed to roll back variant definition", log.String("variant", v.Name), log.Err(delErr))
+		}
+		return nil, cloudflare.ToStatus(err).Err()
+	}
+	return v.ToProto(), nil
+}
+
+// UpdateVariant 更新變體的尺寸與處理方式，先更新 Cloudflare 再更新資料庫中的定義。
+// 資料庫更新失敗時將 Cloudflare 上的變體改回原本的設定，補償失敗時可透過 SyncVariants 同步。
+func (s *variantServer) UpdateVariant(ctx context.Context, req *variant.VariantDefinitionRequest) (*variant.Variant, error) {
+	// 1. 確認使用者是管理員，且變體存在
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prev, err := db.FindVariant(ctx, req.GetName())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 2. 更新 Cloudflare 上的變體
+	v := db.NewVariant(
+		db.WithVariantName(req.GetName()),
+		db.WithVariantSize(req.GetWidth(), req.GetHeight()),
+		db.WithVariantFit(req.GetFit()),
+		db.WithVariantMetadata(req.GetMetadata()),
+		db.WithVariantNeverRequireSignedURLs(req.GetNeverRequireSignedUrls()))
+	err = cloudflare.UpdateVariant(ctx, v.ToCloudflare())
+	if err != nil {
+		return nil, cloudflare.ToStatus(err).Err()
+	}
+	// 3. 更新資料庫中的定義，失敗時還原 Cloudflare 上的變體
+	updated, err := db.UpdateVariant(ctx, v)
+	if err != nil {
+		if revertErr := cloudflare.UpdateVariant(context.WithoutCancel(ctx), prev.ToCloudflare()); revertErr != nil {
+			log.Warn("failed to revert cloudflare variant", log.String("variant", v.Name), log.Err(revertErr))
+		}
+		return nil, db.ToStatus(err).Err()
+	}
+	return updated.ToProto(), nil
+}
+
+// ListVariants 列出所有變體定義。
+func (s *variantServer) ListVariants(ctx context.Context, req *variant.ListVariantsRequest) (*variant.ListVariantsResponse, error) {
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	variants, err := db.ListVariants(ctx)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	resp := &variant.ListVariantsResponse{Variants: make([]*variant.Variant, 0, len(variants))}
+	for _, v := range variants {
+		resp.Variants = append(resp.Variants, v.ToProto())
+	}
+	return resp, nil
+}
+
+// DeleteVariant 刪除變體定義與 Cloudflare 上的變體，已存在的圖片將無法再使用此變體。
+// 資料庫刪除失敗時重新建立 Cloudflare 上的變體。
+func (s *variantServer) DeleteVariant(ctx context.Context, req *variant.VariantRequest) (*variant.DeleteVariantResponse, error) {
+	// 1. 確認使用者是管理員，且變體存在
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prev, err := db.FindVariant(ctx, req.GetName())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 2. 刪除 Cloudflare 上的變體
+	err = cloudflare.DeleteVariant(ctx, req.GetName())
+	if err != nil {
+		return nil, cloudflare.ToStatus(err).Err()
+	}
+	// 3. 刪除資料庫中的定義，失敗時重新建立 Cloudflare 上的變體
+	err = db.DeleteVariant(ctx, req.GetName())
+	if err != nil {
+		if revertErr := cloudflare.CreateVariant(context.WithoutCancel(ctx), prev.ToCloudflare()); revertErr != nil {
+			log.Warn("failed to recreate cloudflare variant", log.String("variant", prev.Name), log.Err(revertErr))
+		}
+		return nil, db.ToStatus(err).Err()
+	}
+	return &variant.DeleteVariantResponse{
+		Message: "Variant deleted successfully",
+	}, nil
+}
+
+// variantSyncPlan 是以 Cloudflare 為準同步變體定義時需要的變更。
+type variantSyncPlan struct {
+	Imported []dao.Variant
+	Updated  []dao.Variant
+	Missing  []string
+}
+
+// planVariantSync 比對 Cloudflare 與資料庫中的變體，stored 以變體名稱為 key。
+// 只存在於資料庫的變體不會被刪除，只列在 Missing 中由管理員決定重新建立或刪除。
+func planVariantSync(remote []dao.Variant, stored map[string]dao.Variant) variantSyncPlan {
+	plan := variantSyncPlan{Imported: []dao.Variant{}, Updated: []dao.Variant{}, Missing: []string{}}
+	seen := make(map[string]struct{}, len(remote))
+	for _, v := range remote {
+		seen[v.ID] = struct{}{}
+		local, ok := stored[v.ID]
+		switch {
+		case !ok:
+			plan.Imported = append(plan.Imported, v)
+		case local != v:
+			plan.Updated = append(plan.Updated, v)
+		}
+	}
+	for name := range stored {
+		if _, ok := seen[name]; !ok {
+			plan.Missing = append(plan.Missing, name)
+		}
+	}
+	sort.Strings(plan.Missing)
+	return plan
+}
+
+// SyncVariants 以 Cloudflare 上的變體為準同步資料庫中的定義：
+// 匯入只存在於 Cloudflare 的變體，更新設定不一致的變體，並列出 Cloudflare 上已不存在的變體。
+func (s *variantServer) SyncVariants(ctx context.Context, req *variant.SyncVariantsRequest) (*variant.SyncVariantsResponse, error) {
+	// 1. 確認使用者是管理員
+	_, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 2. 取得 Cloudflare 與資料庫中的變體
+	remote, err := cloudflare.ListVariants(ctx)
+	if err != nil {
+		return nil, cloudflare.ToStatus(err).Err()
+	}
+	variants, err := db.ListVariants(ctx)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	stored := make(map[string]dao.Variant, len(variants))
+	for _, v := range variants {
+		stored[v.Name] = v.ToCloudflare()
+	}
+	// 3. 匯入與更新變體定義
+	plan := planVariantSync(remote, stored)
+	resp := &variant.SyncVariantsResponse{
+		Imported: make([]string, 0, len(plan.Imported)),
+		Updated:  make([]string, 0, len(plan.Updated)),
+		Missing:  plan.Missing,
+	}
+	for _, v := range plan.Imported {
+		err = db.SaveVariant(ctx, db.VariantFromCloudflare(v))
+		if err != nil {
+			return nil, db.ToStatus(err).Err()
+		}
+		resp.Imported = append(resp.Imported, v.ID)
+	}
+	for _, v := range plan.Updated {
+		_, err = db.UpdateVariant(ctx, db.VariantFromCloudflare(v))
+		if err != nil {
+			return nil, db.ToStatus(err).Err()
+		}
+		resp.Updated = append(resp.Updated, v.ID)
+	}
+	return resp, nil
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/arwoosa/media/internal/cloudflare/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanVariantSync(t *testing.T) {
+	thumb := dao.Variant{ID: "thumb", Width: 200, Height: 200, Fit: "cover", Metadata: "none"}
+	public := dao.Variant{ID: "public", Width: 1366, Height: 768, Fit: "scale-down", Metadata: "keep"}
+	hero := dao.Variant{ID: "hero", Width: 1920, Height: 1080, Fit: "cover", Metadata: "none"}
+
+	stale := public
+	stale.Width = 800
+	plan := planVariantSync([]dao.Variant{hero, public, thumb}, map[string]dao.Variant{
+		"public": stale,
+		"thumb":  thumb,
+		"banner": {ID: "banner", Width: 1200, Height: 300, Fit: "crop", Metadata: "none"},
+	})
+	assert.Equal(t, []dao.Variant{hero}, plan.Imported)
+	assert.Equal(t, []dao.Variant{public}, plan.Updated)
+	assert.Equal(t, []string{"banner"}, plan.Missing)
+}
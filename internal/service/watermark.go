@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arwoosa/media/internal/cloudflare"
+	"github.com/arwoosa/media/internal/db"
+	"github.com/arwoosa/media/internal/pb/watermark"
+	"github.com/arwoosa/media/internal/rdb"
+	"github.com/arwoosa/vulpes/ezgrpc"
+	"github.com/arwoosa/vulpes/log"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watermarkServer 實作了 watermark.WatermarkServiceServer gRPC 服務。
+type watermarkServer struct {
+	watermark.UnimplementedWatermarkServiceServer
+}
+
+func init() {
+	// 將 watermarkServer 注入到 ezgrpc 中，與 imageServer 共用同一個 gRPC 伺服器。
+	ezgrpc.InjectGrpcService(func(s grpc.ServiceRegistrar) {
+		watermark.RegisterWatermarkServiceServer(withInterceptors(s), &watermarkServer{})
+	})
+	// 註冊 gRPC-Gateway 處理程序，將 HTTP 請求代理到 gRPC 服務。
+	ezgrpc.RegisterHandlerFromEndpoint(watermark.RegisterWatermarkServiceHandlerFromEndpoint)
+}
+
+// watermarkSpec 是套用浮水印所需的設定，會與圖片擁有者一起快取在 Redis。
+type watermarkSpec struct {
+	ID         string  `json:"id"`
+	Type       string  `json:"type"`
+	Text       string  `json:"text,omitempty"`
+	OverlayURL string  `json:"overlay_url,omitempty"`
+	Position   string  `json:"position"`
+	Opacity    float32 `json:"opacity"`
+	Scale      float32 `json:"scale"`
+	Mode       string  `json:"mode"`
+}
+
+// Applied 回傳將浮水印寫入圖片內容時的紀錄。
+func (w *watermarkSpec) Applied(at time.Time) *db.AppliedWatermark {
+	return &db.AppliedWatermark{
+		WatermarkID: w.ID,
+		Type:        w.Type,
+		Mode:        w.Mode,
+		AppliedAt:   at,
+	}
+}
+
+// imageDelivery 是傳遞圖片時需要的擁有者、審核與隱藏狀態以及浮水印設定。
+type imageDelivery struct {
+	OwnerID    string         `json:"owner_id"`
+	Moderation string         `json:"moderation,omitempty"`
+	Hidden     bool           `json:"hidden,omitempty"`
+	Watermark  *watermarkSpec `json:"watermark,omitempty"`
+}
+
+// requestedByOwner 判斷請求者是否為圖片擁有者。
+func (d *imageDelivery) requestedByOwner(ctx context.Context) bool {
+	user, err := ezgrpc.GetUser(ctx)
+	return err == nil && user != nil && user.ID != "" && user.ID == d.OwnerID
+}
+
+// watermarkWorkerURL 回傳疊加浮水印的 Cloudflare Worker 位址，可由 watermark.worker_url 設定。
+// Worker 依 query 參數使用 Cloudflare 的 draw 疊加浮水印，參數以 watermark.signing_key 簽章，
+// 兩者任一未設定時不套用浮水印。
+func watermarkWorkerURL() string {
+	if viper.GetString("watermark.signing_key") == "" {
+		return ""
+	}
+	return viper.GetString("watermark.worker_url")
+}
+
+// overlayWatermarkEnabled 判斷是否可以在傳遞時疊加浮水印。原圖必須只能以簽章的 URL 取得，
+// 否則取得者可以直接讀取沒有浮水印的原圖。
+func overlayWatermarkEnabled() bool {
+	return watermarkWorkerURL() != "" && cloudflare.RequireSignedURLs()
+}
+
+// watermarkURL 回傳由浮水印 Worker 提供的圖片 URL。URL 只包含圖片在 CDN 上的路徑，不包含可以直接取得原圖的 URL；
+// Worker 驗證簽章後以 Cloudflare 的簽章金鑰取得原圖並疊加浮水印，簽章也避免參數被竄改而移除浮水印。
+func watermarkURL(endpoint, src string, w *watermarkSpec) string {
+	q := url.Values{}
+	q.Set("type", w.Type)
+	if w.Text != "" {
+		q.Set("text", w.Text)
+	}
+	if w.OverlayURL != "" {
+		q.Set("overlay", deliveryPath(w.OverlayURL))
+	}
+	q.Set("position", w.Position)
+	q.Set("opacity", strconv.FormatFloat(float64(w.Opacity), 'f', -1, 32))
+	q.Set("scale", strconv.FormatFloat(float64(w.Scale), 'f', -1, 32))
+	q.Set("exp", strconv.FormatInt(cloudflare.SignedURLExpiry(time.Now()).Unix(), 10))
+	path := deliveryPath(src)
+	q.Set("sig", cloudflare.HMACHex(viper.GetString("watermark.signing_key"), path+"?"+q.Encode()))
+	return strings.TrimSuffix(endpoint, "/") + path + "?" + q.Encode()
+}
+
+// deliveryPath 回傳圖片 URL 在 CDN 上的路徑，資料庫中的相對路徑直接回傳。
+func deliveryPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || !u.IsAbs() {
+		return uri
+	}
+	return u.EscapedPath()
+}
+
+// resolveWatermarkSpec 查詢圖片適用的浮水印設定，沒有設定時回傳 nil。
+func resolveWatermarkSpec(ctx context.Context, ownerId, imageId string) (*watermarkSpec, error) {
+	w, err := db.ResolveWatermark(ctx, ownerId, imageId)
+	if err != nil || w == nil {
+		return nil, err
+	}
+	return &watermarkSpec{
+		ID:         w.ID.Hex(),
+		Type:       w.Type,
+		Text:       w.Text,
+		OverlayURL: w.OverlayURL,
+		Position:   w.Position,
+		Opacity:    w.Opacity,
+		Scale:      w.Scale,
+		Mode:       w.Mode,
+	}, nil
+}
+
+// imageDeliveryOf 回傳圖片的傳遞設定，優先使用 Redis 快取；已寫入浮水印的圖片不會再疊加浮水印。
+func imageDeliveryOf(ctx context.Context, imageId string) (*imageDelivery, error) {
+	cached, ok, err := rdb.GetImageDelivery(ctx, imageId)
+	if err != nil {
+		log.Warn("failed to read image delivery cache", log.String("image_id", imageId), log.Err(err))
+	}
+	if ok {
+		d := &imageDelivery{}
+		if err := json.Unmarshal([]byte(cached), d); err == nil {
+			return d, nil
+		}
+	}
+	img, err := db.FindImage(ctx, imageId)
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	d := &imageDelivery{OwnerID: img.OwnerID, Moderation: img.ModerationStatus(), Hidden: img.Hidden}
+	if img.Watermark == nil {
+		d.Watermark, err = resolveWatermarkSpec(ctx, img.OwnerID, imageId)
+		if err != nil {
+			return nil, db.ToStatus(err).Err()
+		}
+	}
+	if data, err := json.Marshal(d); err == nil {
+		if err := rdb.SetImageDelivery(ctx, imageId, string(data), variantCacheTTL()); err != nil {
+			log.Warn("failed to write image delivery cache", log.String("image_id", imageId), log.Err(err))
+		}
+	}
+	return d, nil
+}
+
+// applyDeliveryWatermark 為非擁有者的請求疊加 overlay 模式的浮水印，其他請求回傳簽章的原圖 URL。
+func applyDeliveryWatermark(ctx context.Context, d *imageDelivery, uri string) string {
+	if !overlayWatermarkEnabled() || d.Watermark == nil || d.Watermark.Mode != db.WatermarkModeOverlay {
+		return cloudflare.SignDeliveryURL(uri)
+	}
+	// 擁有者看到的是原圖
+	if d.requestedByOwner(ctx) {
+		return cloudflare.SignDeliveryURL(uri)
+	}
+	return watermarkURL(watermarkWorkerURL(), uri, d.Watermark)
+}
+
+// watermarkVariants 回傳提供給請求者的變體 URL：非擁有者的請求疊加浮水印，其他請求為簽章的原圖 URL。
+func watermarkVariants(ctx context.Context, d *imageDelivery, variants map[string]string) map[string]string {
+	if variants == nil {
+		return nil
+	}
+	result := make(map[string]string, len(variants))
+	for name, u := range variants {
+		result[name] = applyDeliveryWatermark(ctx, d, u)
+	}
+	return result
+}
+
+// signVariants 回傳擁有者取得的簽章變體 URL，用於上傳或處理完成時的回應。
+func signVariants(variants map[string]string) map[string]string {
+	if variants == nil {
+		return nil
+	}
+	result := make(map[string]string, len(variants))
+	for name, u := range variants {
+		result[name] = cloudflare.SignDeliveryURL(u)
+	}
+	return result
+}
+
+// invalidateDeliveryCache 清除圖片傳遞設定的快取，讓浮水印變更立即生效；失敗只記錄警告，快取會在逾期後更新。
+func invalidateDeliveryCache(ctx context.Context, imageIds ...string) {
+	if err := rdb.InvalidateImageDelivery(ctx, imageIds...); err != nil {
+		log.Warn("failed to invalidate image delivery cache", log.Int("images", len(imageIds)), log.Err(err))
+	}
+}
+
+// invalidateWatermarkedImages 清除浮水印設定影響的圖片的傳遞設定快取：相簿設定影響相簿中的圖片，擁有者設定影響擁有者所有的圖片。
+func invalidateWatermarkedImages(ctx context.Context, ownerId, albumId string) {
+	imageIds, err := watermarkedImageIds(ctx, ownerId, albumId)
+	if err != nil {
+		log.Warn("failed to list watermarked images", log.String("owner_id", ownerId), log.String("album_id", albumId), log.Err(err))
+		return
+	}
+	invalidateDeliveryCache(ctx, imageIds...)
+}
+
+func watermarkedImageIds(ctx context.Context, ownerId, albumId string) ([]string, error) {
+	if albumId == "" {
+		return db.ListImageIdsByOwner(ctx, ownerId)
+	}
+	a, err := db.FindAlbum(ctx, albumId)
+	if err != nil {
+		return nil, err
+	}
+	return a.ImageIDs, nil
+}
+
+// watermarkOwner 確認使用者可以設定浮水印：未指定相簿時為自己的預設設定，指定相簿時需要相簿的 owner 權限。
+func watermarkOwner(ctx context.Context, albumId string) (string, error) {
+	if albumId == "" {
+		return requireUser(ctx)
+	}
+	return requireAlbumPermission(ctx, albumId, db.PermissionOwner)
+}
+
+// SetWatermark 設定擁有者或相簿的浮水印，圖片浮水印需要浮水印圖片的 viewer 權限。
+func (s *watermarkServer) SetWatermark(ctx context.Context, req *watermark.SetWatermarkRequest) (*watermark.Watermark, error) {
+	// 1. 確認使用者可以設定浮水印
+	userId, err := watermarkOwner(ctx, req.GetAlbumId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 1.1. overlay 模式需要原圖只能以簽章的 URL 取得，否則浮水印可以被繞過
+	if req.GetMode() != db.WatermarkModeBurn && !overlayWatermarkEnabled() {
+		return nil, status.Error(codes.FailedPrecondition, "overlay watermark requires watermark.worker_url, watermark.signing_key and cloudflare.signing_key")
+	}
+
+	// 2. 依類型準備浮水印內容
+	content := db.WithWatermarkText(req.GetText())
+	switch req.GetType() {
+	case db.WatermarkTypeText:
+		if req.GetText() == "" {
+			return nil, status.Error(codes.InvalidArgument, "text is required for text watermark")
+		}
+	case db.WatermarkTypeImage:
+		if req.GetOverlayImageId() == "" {
+			return nil, status.Error(codes.InvalidArgument, "overlay_image_id is required for image watermark")
+		}
+		_, err = requireImagePermission(ctx, req.GetOverlayImageId(), db.PermissionViewer)
+		if err != nil {
+			return nil, err
+		}
+		overlay, err := db.FindImage(ctx, req.GetOverlayImageId())
+		if err != nil {
+			return nil, db.ToStatus(err).Err()
+		}
+		overlayURL, ok := baseVariantURL(overlay.Variants)
+		if !ok {
+			return nil, status.Error(codes.FailedPrecondition, "overlay image has no variant")
+		}
+		content = db.WithWatermarkOverlayImage(overlay.CloudflareID, overlayURL)
+	}
+
+	// 3. 儲存設定
+	saved, err := db.UpsertWatermark(ctx, db.NewWatermark(
+		db.WithWatermarkOwner(userId),
+		db.WithWatermarkAlbum(req.GetAlbumId()),
+		content,
+		db.WithWatermarkPlacement(req.GetPosition(), req.GetOpacity(), req.GetScale()),
+		db.WithWatermarkMode(req.GetMode())))
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	// 4. 清除受影響圖片的傳遞設定快取
+	invalidateWatermarkedImages(ctx, userId, req.GetAlbumId())
+	return saved.ToProto(), nil
+}
+
+// GetWatermark 取得擁有者或相簿的浮水印設定。
+func (s *watermarkServer) GetWatermark(ctx context.Context, req *watermark.WatermarkRequest) (*watermark.Watermark, error) {
+	userId, err := watermarkOwner(ctx, req.GetAlbumId())
+	if err != nil {
+		return nil, err
+	}
+	w, err := db.FindWatermark(ctx, userId, req.GetAlbumId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	return w.ToProto(), nil
+}
+
+// DeleteWatermark 刪除擁有者或相簿的浮水印設定，已寫入衍生圖片的浮水印不受影響。
+func (s *watermarkServer) DeleteWatermark(ctx context.Context, req *watermark.WatermarkRequest) (*watermark.DeleteWatermarkResponse, error) {
+	userId, err := watermarkOwner(ctx, req.GetAlbumId())
+	if err != nil {
+		return nil, err
+	}
+	err = db.DeleteWatermark(ctx, userId, req.GetAlbumId())
+	if err != nil {
+		return nil, db.ToStatus(err).Err()
+	}
+	invalidateWatermarkedImages(ctx, userId, req.GetAlbumId())
+	return &watermark.DeleteWatermarkResponse{
+		Message: "Watermark deleted successfully",
+	}, nil
+}
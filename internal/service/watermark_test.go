@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/arwoosa/media/internal/cloudflare"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWatermarkURL(t *testing.T) {
+	spec := &watermarkSpec{ID: "w1", Type: "text", Text: "© Arwoosa", Position: "bottom-right", Opacity: 0.5, Scale: 0.2, Mode: "overlay"}
+	viper.Set("watermark.signing_key", "wm-key")
+	defer viper.Reset()
+	u, err := url.Parse(watermarkURL("https://wm.example.com/draw/", "https://cdn.example.com/cdn-images/img-1/public", spec))
+	assert.NoError(t, err)
+	assert.Equal(t, "wm.example.com", u.Host)
+	// 只包含圖片的路徑，取得者無法從 URL 讀出原圖的位址
+	assert.Equal(t, "/draw/cdn-images/img-1/public", u.Path)
+
+	q := u.Query()
+	assert.False(t, q.Has("url"))
+	assert.Equal(t, "© Arwoosa", q.Get("text"))
+	assert.Equal(t, "bottom-right", q.Get("position"))
+	assert.Equal(t, "0.5", q.Get("opacity"))
+	assert.Equal(t, "0.2", q.Get("scale"))
+	assert.False(t, q.Has("overlay"))
+
+	// 簽章涵蓋路徑與所有參數，移除或修改參數後簽章不再相符
+	sig := q.Get("sig")
+	q.Del("sig")
+	assert.Equal(t, cloudflare.HMACHex("wm-key", "/cdn-images/img-1/public?"+q.Encode()), sig)
+	q.Set("opacity", "0")
+	assert.NotEqual(t, cloudflare.HMACHex("wm-key", "/cdn-images/img-1/public?"+q.Encode()), sig)
+}
+
+func TestWatermarkVariants(t *testing.T) {
+	defer viper.Reset()
+	variants := map[string]string{"public": "/cdn-images/img-1/public", "thumb": "/cdn-images/img-1/thumb"}
+	d := &imageDelivery{OwnerID: "u1", Watermark: &watermarkSpec{ID: "w1", Type: "text", Text: "©", Position: "center", Opacity: 1, Scale: 0.1, Mode: "overlay"}}
+	visitor := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-id", "u2"))
+	owner := metadata.NewIncomingContext(context.Background(), metadata.Pairs("user-id", "u1"))
+
+	// 未設定 Worker 時不套用浮水印
+	assert.Equal(t, variants, watermarkVariants(visitor, d, variants))
+
+	// 原圖不是只能以簽章取得時，浮水印可以被繞過，因此不提供 overlay
+	viper.Set("watermark.worker_url", "https://wm.example.com/draw")
+	viper.Set("watermark.signing_key", "wm-key")
+	assert.False(t, overlayWatermarkEnabled())
+	assert.Equal(t, variants, watermarkVariants(visitor, d, variants))
+
+	viper.Set("cloudflare.signing_key", "cf-key")
+	assert.True(t, overlayWatermarkEnabled())
+	marked := watermarkVariants(visitor, d, variants)
+	assert.Len(t, marked, 2)
+	for name, u := range marked {
+		parsed, err := url.Parse(u)
+		assert.NoError(t, err)
+		assert.Equal(t, "wm.example.com", parsed.Host)
+		assert.Equal(t, "/draw"+variants[name], parsed.Path)
+		assert.False(t, parsed.Query().Has("url"))
+	}
+
+	// 擁有者看到的是簽章的原圖
+	for name, u := range watermarkVariants(owner, d, variants) {
+		parsed, err := url.Parse(u)
+		assert.NoError(t, err)
+		assert.Equal(t, variants[name], parsed.Path)
+		q := parsed.Query()
+		sig := q.Get("sig")
+		q.Del("sig")
+		assert.Equal(t, cloudflare.HMACHex("cf-key", variants[name]+"?"+q.Encode()), sig)
+	}
+}